@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+data "abion_dns_redirects" "example" {
+  zone = "example.com"
+}
+
+output "example_redirects" {
+  value = data.abion_dns_redirects.example.redirects
+}
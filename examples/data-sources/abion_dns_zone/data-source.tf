@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+data "abion_dns_zone" "example" {
+  zone = "example.com"
+}
+
+resource "abion_dns_a_record" "example" {
+  zone = data.abion_dns_zone.example.zone
+  name = "www"
+  records = [
+    {
+      ip_address = "203.0.113.0"
+      ttl        = data.abion_dns_zone.example.settings.ttl
+    },
+  ]
+
+  lifecycle {
+    precondition {
+      condition     = !data.abion_dns_zone.example.slave
+      error_message = "Records of slave zones can't be managed."
+    }
+  }
+}
+
+output "example_names" {
+  value = data.abion_dns_zone.example.names
+}
@@ -0,0 +1,21 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+data "abion_dns_zone_file" "example" {
+  zone = "example.com"
+}
+
+# Nightly backup of the zone
+resource "local_file" "example_backup" {
+  filename = "${path.module}/backup/example.com.zone"
+  content  = data.abion_dns_zone_file.example.content
+}
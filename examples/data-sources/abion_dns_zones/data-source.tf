@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+data "abion_dns_zones" "example" {
+  name_regex = "\\.se$"
+  slave      = false
+  deleted    = false
+}
+
+resource "abion_dns_caa_record" "example" {
+  for_each = toset(data.abion_dns_zones.example.names)
+
+  zone = each.value
+  name = "@"
+  records = [
+    {
+      flag  = "0"
+      tag   = "issue"
+      value = "letsencrypt.org"
+    },
+  ]
+}
@@ -0,0 +1,25 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+# Publishes the challenge at _acme-challenge.www.example.com in the zone example.com
+resource "abion_dns_acme_challenge" "www" {
+  domain = "www.example.com"
+  values = ["LoqXcYV8q5ONbJQxbmR7SCTNo3tiAXDfowyjxAjEuX0"]
+}
+
+# The challenges of example.com and *.example.com share the same name and coexist
+resource "abion_dns_acme_challenge" "wildcard" {
+  domain = "*.example.com"
+  zone   = "example.com"
+  values = ["2hEpyKTRk8U7cJbZXuy0VgWjuB-l2zLJtyTHUjLgKCk"]
+  ttl    = 60
+}
@@ -0,0 +1,36 @@
+# Only allow Let's Encrypt to issue certificates for example.com, using the given account and DNS validation, and
+# forbid wildcard certificates
+resource "abion_dns_caa_policy" "example" {
+  zone = "example.com"
+
+  issue {
+    issuer             = "letsencrypt.org"
+    account_uri        = "https://acme-v02.api.letsencrypt.org/acme/acct/123456"
+    validation_methods = ["dns-01"]
+  }
+
+  issuewild {
+  }
+
+  iodef {
+    url = "mailto:security@example.com"
+  }
+}
+
+# Allow two certificate authorities to issue certificates for a subdomain
+resource "abion_dns_caa_policy" "shop" {
+  zone = "example.com"
+  name = "shop"
+
+  issue {
+    issuer = "letsencrypt.org"
+  }
+
+  issue {
+    issuer = "sectigo.com"
+  }
+
+  issuewild {
+    issuer = "sectigo.com"
+  }
+}
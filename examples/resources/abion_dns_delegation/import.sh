@@ -0,0 +1,2 @@
+# Delegations can be imported by specifying the string identifier. The import ID should be in the format: "zone/name"
+terraform import abion_dns_delegation.team "example.com/team"
\ No newline at end of file
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+# Delegates team.example.com, with glue for the name server within the subdomain
+resource "abion_dns_delegation" "team" {
+  zone = "example.com"
+  name = "team"
+  nameservers = [
+    {
+      hostname       = "ns1.team.example.com."
+      ipv4_addresses = ["192.0.2.53"]
+      ipv6_addresses = ["2001:db8::53"]
+    },
+    {
+      hostname = "ns1.other-provider.net."
+    },
+  ]
+  ttl = 86400
+}
@@ -0,0 +1,2 @@
+# DKIM keys can be imported by specifying the string identifier. The import ID should be in the format: "zone/name/selector"
+terraform import abion_dns_dkim_key.example "example.com/@/mail2024"
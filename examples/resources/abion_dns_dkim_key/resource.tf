@@ -0,0 +1,17 @@
+# Publish the PEM encoded public key of a key pair at mail2024._domainkey.example.com
+resource "abion_dns_dkim_key" "example" {
+  zone       = "example.com"
+  selector   = "mail2024"
+  public_key = file("${path.module}/dkim/mail2024.pub.pem")
+  h          = ["sha256"]
+  s          = ["email"]
+}
+
+# Publish the p= value given by an email service provider at provider._domainkey.news.example.com
+resource "abion_dns_dkim_key" "provider" {
+  zone       = "example.com"
+  name       = "news"
+  selector   = "provider"
+  public_key = "MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCqXl+0swcN3+GBiz19TpV7FhtLisi2LSrXpcG9LZaFBLoIRUr+i+vgURvubw9PyXseFTI1LBXgxTagjfSw9iqc41cV7dTtgVN5HjRXHWiA/Q+H5iZw9PBoQMhETFNdB7gY5dyPqMz7f/qO4FW3yvJZpk5RCEe2Mf1pcxjSJa7L5wIDAQAB"
+  t          = ["y"]
+}
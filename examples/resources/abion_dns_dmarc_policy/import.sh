@@ -0,0 +1,2 @@
+# DMARC policies can be imported by specifying the string identifier. The import ID should be in the format: "zone/name"
+terraform import abion_dns_dmarc_policy.example "example.com/@"
@@ -0,0 +1,19 @@
+# Publish a DMARC policy for example.com at _dmarc.example.com
+resource "abion_dns_dmarc_policy" "example" {
+  zone  = "example.com"
+  p     = "reject"
+  sp    = "quarantine"
+  rua   = ["mailto:dmarc-reports@example.com"]
+  ruf   = ["mailto:dmarc-failures@example.com!10m"]
+  adkim = "s"
+  aspf  = "s"
+  fo    = ["1"]
+}
+
+# Monitor a subdomain, published at _dmarc.mail.example.com
+resource "abion_dns_dmarc_policy" "mail" {
+  zone = "example.com"
+  name = "mail"
+  p    = "none"
+  rua  = ["mailto:dmarc-reports@example.com"]
+}
@@ -0,0 +1,2 @@
+# MTA-STS records can be imported by specifying the string identifier. The import ID should be in the format: "zone/name"
+terraform import abion_dns_mta_sts.example "example.com/@"
@@ -0,0 +1,20 @@
+# Publish the MTA-STS and TLS-RPT records of example.com. The policy file must be served at
+# https://mta-sts.example.com/.well-known/mta-sts.txt, and the id changes whenever it changes.
+resource "abion_dns_mta_sts" "example" {
+  zone   = "example.com"
+  policy = <<-EOT
+    version: STSv1
+    mode: enforce
+    mx: mail.example.com
+    max_age: 604800
+  EOT
+  rua    = ["mailto:tls-reports@example.com"]
+}
+
+# Derive the id from the hash of a policy file that is deployed elsewhere
+resource "abion_dns_mta_sts" "shop" {
+  zone        = "example.com"
+  name        = "shop"
+  policy_hash = sha256(file("${path.module}/mta-sts/shop.txt"))
+  rua         = ["mailto:tls-reports@example.com", "https://reports.example.com/tls"]
+}
@@ -0,0 +1,2 @@
+# The PTR record of an IP address can be imported by specifying the IP address. The reverse zone is looked up in the zones of the account.
+terraform import abion_dns_ptr_for_ip.mail "192.0.2.25"
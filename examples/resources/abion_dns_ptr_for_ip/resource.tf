@@ -0,0 +1,13 @@
+# Manage the PTR record of an IPv4 address in the longest matching reverse zone, e.g. 2.0.192.in-addr.arpa
+resource "abion_dns_ptr_for_ip" "mail" {
+  ip_address = "192.0.2.25"
+  hostname   = "mail.example.com."
+}
+
+# Manage the PTR record of an IPv6 address in an explicit reverse zone
+resource "abion_dns_ptr_for_ip" "mail_v6" {
+  ip_address = "2001:db8::25"
+  zone       = "8.b.d.0.1.0.0.2.ip6.arpa"
+  hostname   = "mail.example.com."
+  ttl        = 86400
+}
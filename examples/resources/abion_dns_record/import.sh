@@ -0,0 +1,2 @@
+# DNS records can be imported by specifying the string identifier. The import ID should be in the format: "zone/name/type". The `@` character represents the root of the zone, E.g., "example.com/@/A"
+terraform import abion_dns_record.example "example.com/_443._tcp.www/TLSA"
\ No newline at end of file
@@ -0,0 +1,41 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+resource "abion_dns_record" "example" {
+  zone = "example.com"
+  name = "_443._tcp.www"
+  type = "TLSA"
+  records = [
+    {
+      rdata    = "3 1 1 0b9fa5a59eed715c26c1020c711b4f6ec42d58b0015e14337a39dad301c5afc3"
+      ttl      = "3600"
+      comments = "test comment"
+    },
+  ]
+}
+
+# Records of many types driven by a map
+locals {
+  records = {
+    www  = { type = "A", rdata = ["203.0.113.0", "203.0.113.1"] }
+    mail = { type = "MX", rdata = ["10 mx1.example.com.", "20 mx2.example.com."] }
+  }
+}
+
+resource "abion_dns_record" "map_example" {
+  for_each = local.records
+
+  zone    = "example.com"
+  name    = each.key
+  type    = each.value.type
+  records = [for rdata in each.value.rdata : { rdata = rdata }]
+}
@@ -0,0 +1,2 @@
+# DNS record members can be imported by specifying the string identifier. The import ID should be in the format: "zone/name/type/rdata". The `@` character represents the root of the zone, E.g., "example.com/@/TXT/v=spf1 -all"
+terraform import abion_dns_record_member.spf "example.com/@/TXT/v=spf1 include:_spf.google.com -all"
\ No newline at end of file
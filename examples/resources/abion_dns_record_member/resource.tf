@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+# Two modules can each own one TXT record at the root of the zone
+resource "abion_dns_record_member" "google_verification" {
+  zone  = "example.com"
+  name  = "@"
+  type  = "TXT"
+  rdata = "google-site-verification=abc123"
+}
+
+resource "abion_dns_record_member" "spf" {
+  zone     = "example.com"
+  name     = "@"
+  type     = "TXT"
+  rdata    = "v=spf1 include:_spf.google.com -all"
+  ttl      = 3600
+  comments = "managed by the mail module"
+}
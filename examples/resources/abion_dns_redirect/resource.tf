@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+resource "abion_dns_redirect" "example" {
+  zone = "example.com"
+  name = "@"
+  redirects = [
+    {
+      destination = "https://www.example.com/"
+      certificate = true
+    },
+    {
+      path        = "/shop"
+      destination = "https://shop.example.com/"
+      status      = 302
+      slugs       = true
+    },
+  ]
+}
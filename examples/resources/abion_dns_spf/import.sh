@@ -0,0 +1,2 @@
+# SPF records can be imported by specifying the string identifier. The import ID should be in the format: "zone/name"
+terraform import abion_dns_spf.example "example.com/@"
@@ -0,0 +1,16 @@
+# Allow the mail exchangers of example.com, a network of its own and Google Workspace to send mail for the domain
+resource "abion_dns_spf" "example" {
+  zone    = "example.com"
+  ip4     = ["192.0.2.0/24"]
+  ip6     = ["2001:db8::/32"]
+  mx      = ["@"]
+  include = ["_spf.google.com"]
+  all     = "-"
+}
+
+# Use the SPF record of the zone root for a subdomain
+resource "abion_dns_spf" "shop" {
+  zone     = "example.com"
+  name     = "shop"
+  redirect = "example.com"
+}
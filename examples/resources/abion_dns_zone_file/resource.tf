@@ -0,0 +1,31 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+# Manage all records of the zone from a legacy zone file
+resource "abion_dns_zone_file" "example" {
+  zone    = "example.com"
+  content = file("${path.module}/example.com.zone")
+}
+
+# Or inline, with protected records that are managed elsewhere
+resource "abion_dns_zone_file" "inline" {
+  zone = "example.org"
+  content = <<-EOT
+    $TTL 1h
+    @       IN MX   10 mail
+    mail    IN A    192.0.2.10
+    www     IN CNAME @
+    @       IN TXT  "v=spf1 mx -all"
+  EOT
+
+  protected = ["@/NS", "_acme-challenge*/TXT"]
+}
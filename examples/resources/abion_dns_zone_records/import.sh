@@ -0,0 +1,2 @@
+# All records of a zone can be imported by specifying the zone name.
+terraform import abion_dns_zone_records.example "example.com"
\ No newline at end of file
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+resource "abion_dns_zone_records" "example" {
+  zone = "example.com"
+
+  # Never touch the apex NS records and the ACME challenges of the certificate automation
+  protected = ["@/NS", "_acme-challenge*/TXT"]
+
+  records = {
+    "@" = {
+      a = [{ rdata = "203.0.113.0" }]
+      mx = [
+        { rdata = "10 mx1.example.com." },
+        { rdata = "20 mx2.example.com.", ttl = 3600 },
+      ]
+      txt = [{ rdata = "v=spf1 mx -all" }]
+    }
+    "www" = {
+      cname = [{ rdata = "example.com.", comments = "website" }]
+    }
+  }
+}
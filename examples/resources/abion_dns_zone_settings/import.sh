@@ -0,0 +1,2 @@
+# DNS zone settings can be imported by specifying the zone name. The settings found at import are restored when the resource is destroyed.
+terraform import abion_dns_zone_settings.example "example.com"
\ No newline at end of file
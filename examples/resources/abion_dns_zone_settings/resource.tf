@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    abion = {
+      source = "abiondevelopment/abion"
+    }
+  }
+}
+
+provider "abion" {
+  apikey = "<api key>"
+}
+
+resource "abion_dns_zone_settings" "example" {
+  zone    = "example.com"
+  refresh = 10800
+  expire  = 1814400
+  ttl     = 7200
+}
@@ -13,6 +13,7 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"terraform-provider-abion/internal/utils"
 	"time"
@@ -22,6 +23,9 @@ import (
 
 const apiKeyHeader = "X-API-KEY"
 
+// Ensure Client satisfies the ApiClient interface.
+var _ ApiClient = &Client{}
+
 // Client the Abion API client.
 type Client struct {
 	apiKey     string
@@ -50,6 +54,63 @@ func NewAbionClient(host string, apiKey string, timeout int) (*Client, error) {
 	}, nil
 }
 
+// GetZones Returns the zones the API key has access to, one page at a time.
+func (c *Client) GetZones(ctx context.Context, page *Pagination) (*APIResponse[[]Zone], error) {
+	endpoint := c.baseURL.JoinPath("v1", "zones")
+
+	if page != nil {
+		query := endpoint.Query()
+		if page.Offset > 0 {
+			query.Set("offset", strconv.Itoa(page.Offset))
+		}
+		if page.Limit > 0 {
+			query.Set("limit", strconv.Itoa(page.Limit))
+		}
+		endpoint.RawQuery = query.Encode()
+	}
+
+	req, err := newJSONRequest(ctx, http.MethodGet, endpoint, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+
+	results := &APIResponse[[]Zone]{}
+
+	if err := c.do(req, results); err != nil {
+		return nil, fmt.Errorf("could not get zones: %w", err)
+	}
+
+	return results, nil
+}
+
+// GetAllZones Returns all zones the API key has access to, by requesting pages of pageSize zones until the total
+// of the pagination metadata is reached or, without a total, until the last page is reached.
+func (c *Client) GetAllZones(ctx context.Context, pageSize int) ([]Zone, error) {
+	var zones []Zone
+
+	page := &Pagination{Limit: pageSize}
+	for {
+		results, err := c.GetZones(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+
+		zones = append(zones, results.Data...)
+		page.Offset += len(results.Data)
+
+		// The API may cap the limit, so a short page is only the last one if the total is unknown
+		if results.Meta != nil && results.Meta.Pagination != nil && results.Meta.Total > 0 {
+			if len(results.Data) == 0 || page.Offset >= results.Meta.Total {
+				break
+			}
+		} else if len(results.Data) < pageSize {
+			break
+		}
+	}
+
+	return zones, nil
+}
+
 // GetZone Returns the full information on a single zone.
 func (c *Client) GetZone(ctx context.Context, name string) (*APIResponse[*Zone], error) {
 	endpoint := c.baseURL.JoinPath("v1", "zones", name)
@@ -200,7 +261,7 @@ func tryParseHtmlError(resp *http.Response, raw []byte) error {
 
 		if title != "" {
 			// Return the error message with the title
-			return fmt.Errorf("API error: %s", title)
+			return &HTMLError{StatusCode: resp.StatusCode, Title: title}
 		}
 	}
 	return nil
@@ -225,3 +286,32 @@ func CreateRecordPatchRequest(zoneName string, subDomainOrRoot string, recordTyp
 	}
 	return patchRequest
 }
+
+func CreateSettingsPatchRequest(zoneName string, settings Settings) ZoneRequest {
+	patchRequest := ZoneRequest{
+		Data: Zone{
+			Type: "zone",
+			ID:   zoneName,
+			Attributes: Attributes{
+				Settings: &settings,
+			},
+		},
+	}
+	return patchRequest
+}
+
+func CreateRedirectPatchRequest(zoneName string, subDomainOrRoot string, data []Redirect) ZoneRequest {
+	redirects := make(map[string][]Redirect)
+	redirects[subDomainOrRoot] = data
+
+	patchRequest := ZoneRequest{
+		Data: Zone{
+			Type: "zone",
+			ID:   zoneName,
+			Attributes: Attributes{
+				Redirects: redirects,
+			},
+		},
+	}
+	return patchRequest
+}
@@ -0,0 +1,88 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// newTestZonesServer returns a client of a server with total zones, which returns at most maxLimit zones per page if
+// maxLimit is positive.
+func newTestZonesServer(t *testing.T, total int, withTotal bool, maxLimit int) (*Client, *int) {
+	var calls int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		if maxLimit > 0 && limit > maxLimit {
+			limit = maxLimit
+		}
+
+		zones := []Zone{}
+		for i := offset; i < total && i < offset+limit; i++ {
+			zones = append(zones, Zone{Type: "zone", ID: fmt.Sprintf("zone%d.com", i)})
+		}
+
+		response := APIResponse[[]Zone]{Data: zones}
+		if withTotal {
+			response.Meta = &Metadata{Pagination: &Pagination{Offset: offset, Limit: limit, Total: total}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewAbionClient(server.URL, "test", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &calls
+}
+
+func TestGetAllZones(t *testing.T) {
+	tests := []struct {
+		name          string
+		total         int
+		withTotal     bool
+		maxLimit      int
+		expectedCalls int
+	}{
+		{name: "no zones", total: 0, withTotal: true, expectedCalls: 1},
+		{name: "partial last page", total: 5, withTotal: true, expectedCalls: 3},
+		{name: "full last page", total: 6, withTotal: true, expectedCalls: 3},
+		{name: "full last page without total", total: 6, withTotal: false, expectedCalls: 4},
+		{name: "capped limit", total: 5, withTotal: true, maxLimit: 1, expectedCalls: 5},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client, calls := newTestZonesServer(t, test.total, test.withTotal, test.maxLimit)
+
+			zones, err := client.GetAllZones(context.Background(), 2)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(zones) != test.total {
+				t.Errorf("expected %d zones, got %d", test.total, len(zones))
+			}
+			for i, zone := range zones {
+				if expected := fmt.Sprintf("zone%d.com", i); zone.ID != expected {
+					t.Errorf("expected zone %s at index %d, got %s", expected, i, zone.ID)
+				}
+			}
+			if *calls != test.expectedCalls {
+				t.Errorf("expected %d calls, got %d", test.expectedCalls, *calls)
+			}
+		})
+	}
+}
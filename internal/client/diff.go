@@ -0,0 +1,262 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+// DiffZones Computes the minimal JSON Merge Patch (RFC 7396) that transforms the zone from into the zone to.
+//
+// Records, redirects and settings are compared. Names, record types and redirect names that only exist in
+// from are removed by sending null, everything that is unchanged is left out of the patch. Record and redirect
+// lists are always replaced as a whole, as a merge patch can't address single array elements. Settings can be
+// changed but not removed, a nil Settings in to leaves the current settings untouched.
+func DiffZones(from, to Zone) ZoneRequest {
+	id := to.ID
+	if id == "" {
+		id = from.ID
+	}
+
+	patch := ZoneRequest{
+		Data: Zone{
+			Type: "zone",
+			ID:   id,
+			Attributes: Attributes{
+				Records:   diffRecords(from.Attributes.Records, to.Attributes.Records),
+				Redirects: diffRedirects(from.Attributes.Redirects, to.Attributes.Redirects),
+			},
+		},
+	}
+
+	if to.Attributes.Settings != nil && !settingsEqual(from.Attributes.Settings, to.Attributes.Settings) {
+		settings := *to.Attributes.Settings
+		patch.Data.Attributes.Settings = &settings
+	}
+
+	return patch
+}
+
+// IsEmptyPatch Returns true if the patch does not change any records, redirects or settings.
+func IsEmptyPatch(patch ZoneRequest) bool {
+	return len(patch.Data.Attributes.Records) == 0 &&
+		len(patch.Data.Attributes.Redirects) == 0 &&
+		patch.Data.Attributes.Settings == nil
+}
+
+// ApplyZonePatch Applies a JSON Merge Patch (RFC 7396) to the records, redirects and settings of a zone the same
+// way the Abion API does, and returns the patched zone. The given zone is not modified.
+func ApplyZonePatch(zone Zone, patch ZoneRequest) Zone {
+	result := zone
+	result.Attributes.Records = copyRecords(zone.Attributes.Records)
+	result.Attributes.Redirects = copyRedirects(zone.Attributes.Redirects)
+
+	if zone.Attributes.Settings != nil {
+		settings := *zone.Attributes.Settings
+		result.Attributes.Settings = &settings
+	}
+
+	for name, recordTypes := range patch.Data.Attributes.Records {
+		if recordTypes == nil {
+			delete(result.Attributes.Records, name)
+			continue
+		}
+
+		if result.Attributes.Records == nil {
+			result.Attributes.Records = make(map[string]map[string][]Record)
+		}
+		if result.Attributes.Records[name] == nil {
+			result.Attributes.Records[name] = make(map[string][]Record)
+		}
+
+		for recordType, records := range recordTypes {
+			if records == nil {
+				delete(result.Attributes.Records[name], recordType)
+				continue
+			}
+			result.Attributes.Records[name][recordType] = append([]Record{}, records...)
+		}
+
+		if len(result.Attributes.Records[name]) == 0 {
+			delete(result.Attributes.Records, name)
+		}
+	}
+
+	for name, redirects := range patch.Data.Attributes.Redirects {
+		if redirects == nil {
+			delete(result.Attributes.Redirects, name)
+			continue
+		}
+
+		if result.Attributes.Redirects == nil {
+			result.Attributes.Redirects = make(map[string][]Redirect)
+		}
+		result.Attributes.Redirects[name] = append([]Redirect{}, redirects...)
+	}
+
+	if patch.Data.Attributes.Settings != nil {
+		result.Attributes.Settings = mergeSettings(result.Attributes.Settings, patch.Data.Attributes.Settings)
+	}
+
+	return result
+}
+
+func diffRecords(from, to map[string]map[string][]Record) map[string]map[string][]Record {
+	patch := make(map[string]map[string][]Record)
+
+	for name, recordTypes := range from {
+		if hasRecords(recordTypes) && !hasRecords(to[name]) {
+			// all records on this level have been removed
+			patch[name] = nil
+		}
+	}
+
+	for name, recordTypes := range to {
+		if !hasRecords(recordTypes) {
+			continue
+		}
+
+		typePatch := make(map[string][]Record)
+		for recordType, records := range recordTypes {
+			if len(records) > 0 && !recordsEqual(from[name][recordType], records) {
+				typePatch[recordType] = records
+			}
+		}
+		for recordType, records := range from[name] {
+			if len(records) > 0 && len(recordTypes[recordType]) == 0 {
+				typePatch[recordType] = nil
+			}
+		}
+
+		if len(typePatch) > 0 {
+			patch[name] = typePatch
+		}
+	}
+
+	if len(patch) == 0 {
+		return nil
+	}
+	return patch
+}
+
+func diffRedirects(from, to map[string][]Redirect) map[string][]Redirect {
+	patch := make(map[string][]Redirect)
+
+	for name, redirects := range from {
+		if len(redirects) > 0 && len(to[name]) == 0 {
+			patch[name] = nil
+		}
+	}
+
+	for name, redirects := range to {
+		if len(redirects) > 0 && !redirectsEqual(from[name], redirects) {
+			patch[name] = redirects
+		}
+	}
+
+	if len(patch) == 0 {
+		return nil
+	}
+	return patch
+}
+
+func hasRecords(recordTypes map[string][]Record) bool {
+	for _, records := range recordTypes {
+		if len(records) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func recordsEqual(a, b []Record) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !recordEqual(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func recordEqual(a, b Record) bool {
+	return a.Data == b.Data && intPointerEqual(a.TTL, b.TTL) && stringPointerEqual(a.Comments, b.Comments)
+}
+
+func redirectsEqual(a, b []Redirect) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func settingsEqual(a, b *Settings) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// mergeSettings Merges the patch into the settings, fields left out of the patch JSON (zero values) are kept.
+func mergeSettings(settings *Settings, patch *Settings) *Settings {
+	result := Settings{}
+	if settings != nil {
+		result = *settings
+	}
+	if patch.MName != "" {
+		result.MName = patch.MName
+	}
+	if patch.Refresh != 0 {
+		result.Refresh = patch.Refresh
+	}
+	if patch.Expire != 0 {
+		result.Expire = patch.Expire
+	}
+	if patch.TTL != 0 {
+		result.TTL = patch.TTL
+	}
+	return &result
+}
+
+func intPointerEqual(a, b *int) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func stringPointerEqual(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func copyRecords(records map[string]map[string][]Record) map[string]map[string][]Record {
+	if records == nil {
+		return nil
+	}
+	result := make(map[string]map[string][]Record, len(records))
+	for name, recordTypes := range records {
+		result[name] = make(map[string][]Record, len(recordTypes))
+		for recordType, data := range recordTypes {
+			result[name][recordType] = append([]Record{}, data...)
+		}
+	}
+	return result
+}
+
+func copyRedirects(redirects map[string][]Redirect) map[string][]Redirect {
+	if redirects == nil {
+		return nil
+	}
+	result := make(map[string][]Redirect, len(redirects))
+	for name, data := range redirects {
+		result[name] = append([]Redirect{}, data...)
+	}
+	return result
+}
@@ -0,0 +1,355 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+)
+
+var (
+	testNames       = []string{"@", "www", "mail", "_dmarc", "www.east", "*"}
+	testRecordTypes = []string{"A", "AAAA", "MX", "TXT"}
+	testRData       = []string{"203.0.113.0", "203.0.113.1", "10 mail.example.com.", "v=spf1 -all"}
+	testTTLs        = []int{300, 3600}
+	testComments    = []string{"test comment", "managed by terraform"}
+	testPaths       = []string{"/", "/shop", "/blog"}
+	testURLs        = []string{"https://example.com", "https://example.com/new"}
+	testStatuses    = []int{301, 302, 307, 308}
+)
+
+// zonePair is a random pair of zones, the second one often derived from the first one so that the
+// zones share names, record types and redirects.
+type zonePair struct {
+	From Zone
+	To   Zone
+}
+
+func (zonePair) Generate(r *rand.Rand, _ int) reflect.Value {
+	from := randomZone(r)
+
+	var to Zone
+	if r.Intn(4) == 0 {
+		to = randomZone(r)
+		if from.Attributes.Settings != nil && to.Attributes.Settings == nil {
+			// settings can't be removed by a merge patch
+			to.Attributes.Settings = randomSettings(r)
+		}
+	} else {
+		to = mutateZone(r, from)
+	}
+
+	return reflect.ValueOf(zonePair{From: from, To: to})
+}
+
+func randomZone(r *rand.Rand) Zone {
+	zone := Zone{Type: "zone", ID: "example.com"}
+
+	if r.Intn(3) > 0 {
+		zone.Attributes.Settings = randomSettings(r)
+	}
+
+	for _, name := range testNames {
+		if r.Intn(2) == 0 {
+			continue
+		}
+		if zone.Attributes.Records == nil {
+			zone.Attributes.Records = make(map[string]map[string][]Record)
+		}
+		zone.Attributes.Records[name] = make(map[string][]Record)
+		for _, recordType := range testRecordTypes {
+			if r.Intn(2) == 0 {
+				zone.Attributes.Records[name][recordType] = randomRecords(r)
+			}
+		}
+	}
+
+	for _, name := range testNames {
+		if r.Intn(3) == 0 {
+			if zone.Attributes.Redirects == nil {
+				zone.Attributes.Redirects = make(map[string][]Redirect)
+			}
+			zone.Attributes.Redirects[name] = randomRedirects(r)
+		}
+	}
+
+	return zone
+}
+
+func mutateZone(r *rand.Rand, zone Zone) Zone {
+	result := zone
+	result.Attributes.Records = copyRecords(zone.Attributes.Records)
+	result.Attributes.Redirects = copyRedirects(zone.Attributes.Redirects)
+
+	if r.Intn(2) == 0 {
+		result.Attributes.Settings = randomSettings(r)
+	}
+
+	for i := r.Intn(4); i > 0; i-- {
+		name := testNames[r.Intn(len(testNames))]
+		recordType := testRecordTypes[r.Intn(len(testRecordTypes))]
+
+		if result.Attributes.Records == nil {
+			result.Attributes.Records = make(map[string]map[string][]Record)
+		}
+
+		switch r.Intn(4) {
+		case 0:
+			delete(result.Attributes.Records, name)
+		case 1:
+			delete(result.Attributes.Records[name], recordType)
+		default:
+			if result.Attributes.Records[name] == nil {
+				result.Attributes.Records[name] = make(map[string][]Record)
+			}
+			result.Attributes.Records[name][recordType] = randomRecords(r)
+		}
+	}
+
+	for i := r.Intn(3); i > 0; i-- {
+		name := testNames[r.Intn(len(testNames))]
+		if r.Intn(2) == 0 {
+			delete(result.Attributes.Redirects, name)
+			continue
+		}
+		if result.Attributes.Redirects == nil {
+			result.Attributes.Redirects = make(map[string][]Redirect)
+		}
+		result.Attributes.Redirects[name] = randomRedirects(r)
+	}
+
+	return result
+}
+
+func randomSettings(r *rand.Rand) *Settings {
+	return &Settings{
+		MName:   []string{"ns1.example.com.", "ns2.example.com."}[r.Intn(2)],
+		Refresh: 1200 + r.Intn(3)*1200,
+		Expire:  1209600 + r.Intn(3)*86400,
+		TTL:     testTTLs[r.Intn(len(testTTLs))],
+	}
+}
+
+func randomRecords(r *rand.Rand) []Record {
+	records := make([]Record, 1+r.Intn(3))
+	for i := range records {
+		records[i].Data = testRData[r.Intn(len(testRData))]
+		if r.Intn(2) == 0 {
+			ttl := testTTLs[r.Intn(len(testTTLs))]
+			records[i].TTL = &ttl
+		}
+		if r.Intn(2) == 0 {
+			comments := testComments[r.Intn(len(testComments))]
+			records[i].Comments = &comments
+		}
+	}
+	return records
+}
+
+func randomRedirects(r *rand.Rand) []Redirect {
+	redirects := make([]Redirect, 1+r.Intn(2))
+	for i := range redirects {
+		redirects[i] = Redirect{
+			Path:        testPaths[r.Intn(len(testPaths))],
+			Destination: testURLs[r.Intn(len(testURLs))],
+			Status:      testStatuses[r.Intn(len(testStatuses))],
+			Slugs:       r.Intn(2) == 0,
+			Certificate: r.Intn(2) == 0,
+		}
+	}
+	return redirects
+}
+
+// normalizeZone Returns the parts of a zone a patch can change, without empty names and record types.
+func normalizeZone(zone Zone) Attributes {
+	result := Attributes{Settings: zone.Attributes.Settings}
+
+	for name, recordTypes := range zone.Attributes.Records {
+		for recordType, records := range recordTypes {
+			if len(records) == 0 {
+				continue
+			}
+			if result.Records == nil {
+				result.Records = make(map[string]map[string][]Record)
+			}
+			if result.Records[name] == nil {
+				result.Records[name] = make(map[string][]Record)
+			}
+			result.Records[name][recordType] = records
+		}
+	}
+
+	for name, redirects := range zone.Attributes.Redirects {
+		if len(redirects) == 0 {
+			continue
+		}
+		if result.Redirects == nil {
+			result.Redirects = make(map[string][]Redirect)
+		}
+		result.Redirects[name] = redirects
+	}
+
+	return result
+}
+
+// mergePatch is a plain implementation of the RFC 7396 MergePatch algorithm on decoded JSON.
+func mergePatch(target any, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = make(map[string]any)
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+		} else {
+			targetObject[key] = mergePatch(targetObject[key], value)
+		}
+	}
+	return targetObject
+}
+
+func applyJSONMergePatch(t *testing.T, zone Zone, patch ZoneRequest) Zone {
+	var target, patchDoc any
+
+	raw, err := json.Marshal(zone)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, &target); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err = json.Marshal(patch.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(raw, &patchDoc); err != nil {
+		t.Fatal(err)
+	}
+
+	raw, err = json.Marshal(mergePatch(target, patchDoc))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var result Zone
+	if err := json.Unmarshal(raw, &result); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+func TestDiffZonesJSONMergePatchYieldsNewZone(t *testing.T) {
+	property := func(pair zonePair) bool {
+		patch := DiffZones(pair.From, pair.To)
+		result := applyJSONMergePatch(t, pair.From, patch)
+		return reflect.DeepEqual(normalizeZone(result), normalizeZone(pair.To))
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiffZonesApplyZonePatchYieldsNewZone(t *testing.T) {
+	property := func(pair zonePair) bool {
+		patch := DiffZones(pair.From, pair.To)
+		result := ApplyZonePatch(pair.From, patch)
+		return reflect.DeepEqual(normalizeZone(result), normalizeZone(pair.To))
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiffZonesIsMinimal(t *testing.T) {
+	property := func(pair zonePair) bool {
+		patch := DiffZones(pair.From, pair.To)
+		from := normalizeZone(pair.From)
+		to := normalizeZone(pair.To)
+
+		for name, recordTypes := range patch.Data.Attributes.Records {
+			if recordTypes == nil {
+				if from.Records[name] == nil || to.Records[name] != nil {
+					return false
+				}
+				continue
+			}
+			for recordType := range recordTypes {
+				if reflect.DeepEqual(from.Records[name][recordType], to.Records[name][recordType]) {
+					return false
+				}
+			}
+		}
+
+		for name := range patch.Data.Attributes.Redirects {
+			if reflect.DeepEqual(from.Redirects[name], to.Redirects[name]) {
+				return false
+			}
+		}
+
+		if patch.Data.Attributes.Settings != nil && reflect.DeepEqual(from.Settings, to.Settings) {
+			return false
+		}
+		return true
+	}
+
+	if err := quick.Check(property, &quick.Config{MaxCount: 1000}); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiffZonesIdenticalZones(t *testing.T) {
+	property := func(pair zonePair) bool {
+		return IsEmptyPatch(DiffZones(pair.From, pair.From))
+	}
+
+	if err := quick.Check(property, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDiffZonesNullForDeletions(t *testing.T) {
+	ttl := 3600
+	from := Zone{
+		ID: "example.com",
+		Attributes: Attributes{
+			Records: map[string]map[string][]Record{
+				"@":   {"A": {{Data: "203.0.113.0"}}, "TXT": {{Data: "txt data", TTL: &ttl}}},
+				"www": {"A": {{Data: "203.0.113.1"}}},
+			},
+			Redirects: map[string][]Redirect{
+				"shop": {{Path: "/", Destination: "https://example.com", Status: 301}},
+			},
+		},
+	}
+	to := Zone{
+		ID: "example.com",
+		Attributes: Attributes{
+			Records: map[string]map[string][]Record{
+				"@": {"A": {{Data: "203.0.113.0"}}},
+			},
+		},
+	}
+
+	raw, err := json.Marshal(DiffZones(from, to))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := `{"data":{"type":"zone","id":"example.com","attributes":{"records":{"@":{"TXT":null},"www":null},"redirects":{"shop":null}}}}`
+	if string(raw) != expected {
+		t.Errorf("unexpected patch\nexpected: %s\nactual:   %s", expected, raw)
+	}
+}
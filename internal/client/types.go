@@ -73,3 +73,14 @@ type Error struct {
 func (e *Error) Error() string {
 	return fmt.Sprintf("api error: status=%d, message=%s", e.Status, e.Message)
 }
+
+// HTMLError is returned when the API responds with an HTML page instead of JSON, e.g. when the IP address is not
+// whitelisted by Abion.
+type HTMLError struct {
+	StatusCode int
+	Title      string
+}
+
+func (e *HTMLError) Error() string {
+	return fmt.Sprintf("API error: %s", e.Title)
+}
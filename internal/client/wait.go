@@ -0,0 +1,174 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	waitInitialDelay = 1 * time.Second
+	waitMaxDelay     = 30 * time.Second
+)
+
+// WaitForPatch Polls the zone with exponential backoff until the changes of the patch are visible and the zone is
+// no longer pending, or until the timeout (or an earlier deadline of the context) passes.
+func (c *Client) WaitForPatch(ctx context.Context, name string, patch ZoneRequest, timeout time.Duration) error {
+	return c.WaitForZone(ctx, name, timeout, func(zone Zone) error {
+		if !containsPatch(zone, patch) {
+			return fmt.Errorf("zone %s does not contain the patched changes yet", name)
+		}
+		return nil
+	})
+}
+
+// containsPatch returns true if the zone contains the changes of the patch. Only the patched fields are compared:
+// records are matched regardless of their order and of how the API formats the data, and the TTL and comments of a
+// record are only compared if the patch sets them.
+func containsPatch(zone Zone, patch ZoneRequest) bool {
+	for name, recordTypes := range patch.Data.Attributes.Records {
+		if recordTypes == nil {
+			if hasRecords(zone.Attributes.Records[name]) {
+				return false
+			}
+			continue
+		}
+
+		for recordType, records := range recordTypes {
+			if !containsRecords(zone.Attributes.Records[name][recordType], records) {
+				return false
+			}
+		}
+	}
+
+	for name, redirects := range patch.Data.Attributes.Redirects {
+		if !containsRedirects(zone.Attributes.Redirects[name], redirects) {
+			return false
+		}
+	}
+
+	if settings := patch.Data.Attributes.Settings; settings != nil {
+		current := zone.Attributes.Settings
+		if current == nil {
+			current = &Settings{}
+		}
+		if (settings.MName != "" && normalizeRecordData(settings.MName) != normalizeRecordData(current.MName)) ||
+			(settings.Refresh != 0 && settings.Refresh != current.Refresh) ||
+			(settings.Expire != 0 && settings.Expire != current.Expire) ||
+			(settings.TTL != 0 && settings.TTL != current.TTL) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsRecords returns true if the current records of a record type match the patched records one to one,
+// regardless of their order.
+func containsRecords(current []Record, patched []Record) bool {
+	if len(current) != len(patched) {
+		return false
+	}
+
+	matched := make([]bool, len(current))
+	for _, record := range patched {
+		found := false
+		for i := range current {
+			if !matched[i] && recordContains(current[i], record) {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// recordContains returns true if the current record has the data of the patched record, and its TTL and comments if
+// the patched record sets them.
+func recordContains(current Record, patched Record) bool {
+	return normalizeRecordData(current.Data) == normalizeRecordData(patched.Data) &&
+		(patched.TTL == nil || intPointerEqual(current.TTL, patched.TTL)) &&
+		(patched.Comments == nil || stringPointerEqual(current.Comments, patched.Comments))
+}
+
+// containsRedirects returns true if the current redirects of a name are the patched redirects, regardless of their
+// order.
+func containsRedirects(current []Redirect, patched []Redirect) bool {
+	if len(current) != len(patched) {
+		return false
+	}
+
+	matched := make([]bool, len(current))
+	for _, redirect := range patched {
+		found := false
+		for i := range current {
+			if !matched[i] && current[i] == redirect {
+				matched[i], found = true, true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// normalizeRecordData returns the record data in a form that doesn't depend on how the API formats it, i.e. with
+// character strings joined, without quotes, whitespace collapsed, in lower case and without a trailing dot.
+func normalizeRecordData(data string) string {
+	data = strings.ReplaceAll(data, `" "`, "")
+	data = strings.ReplaceAll(data, `"`, "")
+	data = strings.Join(strings.Fields(data), " ")
+	return strings.ToLower(strings.TrimSuffix(data, "."))
+}
+
+// WaitForZone polls the zone with exponential backoff until the condition returns no error and the zone is no longer
+// pending, or until the timeout (or an earlier deadline of the context) passes.
+func (c *Client) WaitForZone(ctx context.Context, name string, timeout time.Duration, condition func(zone Zone) error) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	delay := waitInitialDelay
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		zone, err := c.GetZone(ctx, name)
+
+		switch {
+		case err != nil:
+			lastErr = err
+		case zone.Data == nil:
+			lastErr = fmt.Errorf("zone %s returned no data", name)
+		case zone.Data.Attributes.Pending:
+			lastErr = fmt.Errorf("zone %s has pending changes", name)
+		default:
+			lastErr = condition(*zone.Data)
+			if lastErr == nil {
+				return nil
+			}
+		}
+
+		tflog.Debug(ctx, "Zone not consistent yet", map[string]any{"attempt": attempt, "reason": lastErr.Error()})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("zone %s not consistent after %d attempts: %w", name, attempt, lastErr)
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > waitMaxDelay {
+			delay = waitMaxDelay
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"terraform-provider-abion/internal/utils"
+)
+
+func newTestZoneServer(t *testing.T, zone func(call int32) Zone) (*Client, *int32) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := atomic.AddInt32(&calls, 1)
+		w.Header().Set("Content-Type", "application/json")
+		data := zone(call)
+		_ = json.NewEncoder(w).Encode(APIResponse[*Zone]{Data: &data})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := NewAbionClient(server.URL, "test", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &calls
+}
+
+func TestWaitForPatchPendingZone(t *testing.T) {
+	patch := CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, []Record{{Data: "203.0.113.0"}})
+
+	client, calls := newTestZoneServer(t, func(call int32) Zone {
+		zone := Zone{ID: "example.com", Attributes: Attributes{Pending: call < 2}}
+		zone.Attributes.Records = map[string]map[string][]Record{"www": {"A": {{Data: "203.0.113.0"}}}}
+		return zone
+	})
+
+	if err := client.WaitForPatch(context.Background(), "example.com", patch, 10*time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *calls != 2 {
+		t.Errorf("expected 2 calls, got %d", *calls)
+	}
+}
+
+func TestWaitForPatchTimeout(t *testing.T) {
+	patch := CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, []Record{{Data: "203.0.113.0"}})
+
+	client, _ := newTestZoneServer(t, func(call int32) Zone {
+		zone := Zone{ID: "example.com"}
+		zone.Attributes.Records = map[string]map[string][]Record{"www": {"A": {{Data: "203.0.113.1"}}}}
+		return zone
+	})
+
+	err := client.WaitForPatch(context.Background(), "example.com", patch, 1500*time.Millisecond)
+	if err == nil || !strings.Contains(err.Error(), "does not contain the patched changes") {
+		t.Fatalf("expected consistency error, got: %v", err)
+	}
+}
+
+func TestWaitForPatchNormalizedZone(t *testing.T) {
+	ttl := 3600
+	patch := CreateRecordPatchRequest("example.com", "@", utils.RecordTypeMX, []Record{{Data: "10 mail.example.com."}, {Data: "20 Backup.example.com."}})
+	patch.Data.Attributes.Records["@"][utils.RecordTypeTXT.String()] = []Record{{Data: `"v=spf1" " -all"`, TTL: &ttl}}
+
+	client, calls := newTestZoneServer(t, func(call int32) Zone {
+		comments := "filled in by the API"
+		zone := Zone{ID: "example.com"}
+		zone.Attributes.Records = map[string]map[string][]Record{"@": {
+			"MX":  {{Data: "20 backup.example.com", TTL: &ttl}, {Data: "10 mail.example.com", TTL: &ttl, Comments: &comments}},
+			"TXT": {{Data: "v=spf1 -all", TTL: &ttl}},
+		}}
+		return zone
+	})
+
+	if err := client.WaitForPatch(context.Background(), "example.com", patch, 10*time.Second); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if *calls != 1 {
+		t.Errorf("expected 1 call, got %d", *calls)
+	}
+}
+
+func TestContainsPatch(t *testing.T) {
+	ttl, otherTTL := 300, 600
+	zone := Zone{ID: "example.com"}
+	zone.Attributes.Records = map[string]map[string][]Record{"www": {"A": {{Data: "203.0.113.0", TTL: &otherTTL}}}}
+
+	tests := map[string]struct {
+		patch    ZoneRequest
+		expected bool
+	}{
+		"ttl not patched": {CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, []Record{{Data: "203.0.113.0"}}), true},
+		"ttl differs":     {CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, []Record{{Data: "203.0.113.0", TTL: &ttl}}), false},
+		"extra record":    {CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, []Record{{Data: "203.0.113.0"}, {Data: "203.0.113.1"}}), false},
+		"removed type":    {CreateRecordPatchRequest("example.com", "www", utils.RecordTypeA, nil), false},
+		"removed absent":  {CreateRecordPatchRequest("example.com", "ftp", utils.RecordTypeA, nil), true},
+	}
+
+	for name, test := range tests {
+		if actual := containsPatch(zone, test.patch); actual != test.expected {
+			t.Errorf("%s: expected %t, got %t", name, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+// waitForConsistency waits until the changes of the patch are visible in the zone. Waiting is enabled by the
+// wait_for_consistency attribute of the resource or, if not set on the resource, by the provider configuration.
+func waitForConsistency(ctx context.Context, providerData *AbionProviderData, resourceWait types.Bool, zone string, patch abionclient.ZoneRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wait := providerData.WaitForConsistency
+	if !resourceWait.IsNull() && !resourceWait.IsUnknown() {
+		wait = resourceWait.ValueBool()
+	}
+
+	if !wait {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "consistency_timeout", providerData.ConsistencyTimeout.String())
+	tflog.Debug(ctx, "Waiting for zone changes to become visible")
+
+	err := providerData.Client.WaitForPatch(ctx, zone, patch, providerData.ConsistencyTimeout)
+	if err != nil {
+		diags.AddError(
+			"Zone changes not visible",
+			"The zone was patched, but the changes did not become visible before the consistency timeout passed: "+err.Error(),
+		)
+	}
+
+	return diags
+}
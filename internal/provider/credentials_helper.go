@@ -0,0 +1,88 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net"
+	"net/http"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+// validateCredentials makes a lightweight request to the Abion API to verify the API key, the IP whitelisting and
+// the connectivity to the host, so that a misconfiguration is reported once by the provider instead of by every
+// resource and data source.
+func validateCredentials(ctx context.Context, client *abionclient.Client, host string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tflog.Debug(ctx, "Validating Abion API credentials")
+
+	_, err := client.GetZones(ctx, &abionclient.Pagination{Limit: 1})
+	if err != nil {
+		summary, detail := classifyCredentialsError(err, host)
+		diags.AddError(
+			summary,
+			detail+"\n\n"+
+				"Set skip_credentials_validation = true in the provider configuration to skip this validation, "+
+				"e.g. to run plans offline.\n\n"+
+				"Abion Client Error: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// classifyCredentialsError returns a diagnostic summary and detail describing the most likely cause of an error
+// returned by the Abion API during credentials validation.
+func classifyCredentialsError(err error, host string) (string, string) {
+	var apiErr *abionclient.Error
+	var htmlErr *abionclient.HTMLError
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	var certErr *tls.CertificateVerificationError
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+
+	switch {
+	case errors.As(err, &apiErr) && (apiErr.Status == http.StatusUnauthorized || apiErr.Status == http.StatusForbidden):
+		return "Invalid Abion API Key",
+			"The Abion API rejected the API key. Verify that the apikey value or the ABION_API_KEY environment variable " +
+				"contains a valid, non-expired Abion API key."
+	case errors.As(err, &htmlErr) && htmlErr.StatusCode == http.StatusForbidden:
+		return "Abion API Access Not Whitelisted",
+			"The Abion API denied access to this IP address. Contact Abion to get the IP addresses Terraform runs from " +
+				"whitelisted."
+	case errors.As(err, &htmlErr):
+		return "Unexpected Abion API Response",
+			"The host " + host + " did not respond like the Abion API. Verify the host value or the ABION_API_HOST " +
+				"environment variable."
+	case errors.As(err, &dnsErr):
+		return "Unable to Resolve Abion API Host",
+			"The host " + host + " could not be resolved. Verify the host value or the ABION_API_HOST environment " +
+				"variable, and the DNS configuration of the machine Terraform runs on."
+	case errors.As(err, &certErr), errors.As(err, &unknownAuthorityErr), errors.As(err, &hostnameErr),
+		errors.As(err, &certInvalidErr), errors.As(err, &recordHeaderErr):
+		return "Abion API TLS Error",
+			"A secure connection to " + host + " could not be established. Verify that the host uses the https scheme " +
+				"and that the certificate of the host is trusted by the machine Terraform runs on."
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "Abion API Timeout",
+			"The Abion API at " + host + " did not respond in time. Verify the connectivity to the host, or increase " +
+				"the timeout value or the ABION_API_TIMEOUT environment variable."
+	case errors.As(err, &apiErr):
+		return "Abion API Error",
+			"The Abion API returned an unexpected error while validating the credentials."
+	default:
+		return "Unable to Connect to Abion API",
+			"The provider could not connect to the Abion API at " + host + ". Verify the host value or the " +
+				"ABION_API_HOST environment variable, and the connectivity to the host."
+	}
+}
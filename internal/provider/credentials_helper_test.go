@@ -0,0 +1,106 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+func TestValidateCredentials(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler http.HandlerFunc
+		tls     bool
+		summary string
+	}{
+		{
+			name: "valid",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Query().Get("limit") != "1" {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+				_, _ = w.Write([]byte(`{"meta":{"offset":0,"limit":1,"total":1},"data":[{"type":"zone","id":"example.com"}]}`))
+			},
+		},
+		{
+			name: "invalid api key",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"error":{"status":401,"message":"Unauthorized"}}`))
+			},
+			summary: "Invalid Abion API Key",
+		},
+		{
+			name: "not whitelisted",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`<html><head><title>403 Forbidden</title></head></html>`))
+			},
+			summary: "Abion API Access Not Whitelisted",
+		},
+		{
+			name: "untrusted certificate",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = w.Write([]byte(`{"data":[]}`))
+			},
+			tls:     true,
+			summary: "Abion API TLS Error",
+		},
+		{
+			name: "timeout",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				time.Sleep(1500 * time.Millisecond)
+			},
+			summary: "Abion API Timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewUnstartedServer(test.handler)
+			if test.tls {
+				server.StartTLS()
+			} else {
+				server.Start()
+			}
+			defer server.Close()
+
+			client, err := abionclient.NewAbionClient(server.URL, "test", 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := validateCredentials(context.Background(), client, server.URL)
+
+			if test.summary == "" {
+				if diags.HasError() {
+					t.Fatalf("unexpected error: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags[0].Summary() != test.summary {
+				t.Fatalf("expected %q error, got: %v", test.summary, diags)
+			}
+		})
+	}
+}
+
+func TestValidateCredentialsUnresolvableHost(t *testing.T) {
+	client, err := abionclient.NewAbionClient("https://api.abion.invalid", "test", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := validateCredentials(context.Background(), client, "https://api.abion.invalid")
+	if !diags.HasError() || diags[0].Summary() != "Unable to Resolve Abion API Host" {
+		t.Fatalf("expected DNS error, got: %v", diags)
+	}
+}
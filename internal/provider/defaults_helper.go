@@ -0,0 +1,70 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// modifyPlanRecordDefaults plans the default_ttl and default_comments of the provider for every record of the
+// list attribute at recordsPath that leaves ttl or comments unset in the configuration.
+func modifyPlanRecordDefaults(ctx context.Context, providerData *AbionProviderData, recordsPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var records types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, recordsPath, &records)...)
+	if resp.Diagnostics.HasError() || records.IsNull() || records.IsUnknown() {
+		return
+	}
+
+	for i := range records.Elements() {
+		planRecordDefaults(ctx, providerData, recordsPath.AtListIndex(i), req, resp)
+	}
+}
+
+// modifyPlanSingleRecordDefaults plans the default_ttl and default_comments of the provider for the single nested
+// record attribute at recordPath if it leaves ttl or comments unset in the configuration.
+func modifyPlanSingleRecordDefaults(ctx context.Context, providerData *AbionProviderData, recordPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the resource is destroyed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var record types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, recordPath, &record)...)
+	if resp.Diagnostics.HasError() || record.IsNull() || record.IsUnknown() {
+		return
+	}
+
+	planRecordDefaults(ctx, providerData, recordPath, req, resp)
+}
+
+// planRecordDefaults plans the ttl and comments of a single record. Values set in the configuration always take
+// precedence, unset values are planned as the provider default, or as null when there is no default.
+func planRecordDefaults(ctx context.Context, providerData *AbionProviderData, recordPath path.Path, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	defaultTTL := types.Int32Null()
+	defaultComments := types.StringNull()
+	if providerData != nil {
+		defaultTTL = providerData.DefaultTTL
+		defaultComments = providerData.DefaultComments
+	}
+
+	var ttl types.Int32
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, recordPath.AtName("ttl"), &ttl)...)
+	if ttl.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, recordPath.AtName("ttl"), defaultTTL)...)
+	}
+
+	var comments types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, recordPath.AtName("comments"), &comments)...)
+	if comments.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, recordPath.AtName("comments"), defaultComments)...)
+	}
+}
@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &dnsARecordResource{}
 	_ resource.ResourceWithConfigure   = &dnsARecordResource{}
 	_ resource.ResourceWithImportState = &dnsARecordResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsARecordResource{}
 )
 
 // NewDnsARecordResource is a helper function to simplify the provider implementation.
@@ -28,8 +31,16 @@ func NewDnsARecordResource() resource.Resource {
 
 // dnsARecordResource is the resource implementation.
 type dnsARecordResource struct {
-	client     *abionclient.Client
-	recordType utils.RecordType
+	client       *abionclient.Client
+	providerData *AbionProviderData
+	recordType   utils.RecordType
+}
+
+// dnsARecordResourceModel maps the resource schema data.
+type dnsARecordResourceModel struct {
+	dnsARecordModel
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dnsARecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -39,16 +50,17 @@ func (r *dnsARecordResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*abionclient.Client)
+	providerData, ok := req.ProviderData.(*AbionProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *abionclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
 	r.recordType = utils.RecordTypeA
 }
 
@@ -58,7 +70,7 @@ func (r *dnsARecordResource) Metadata(_ context.Context, req resource.MetadataRe
 }
 
 // Schema defines the schema for the resource.
-func (r *dnsARecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *dnsARecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this resource to create, update and delete DNS A records of a zone.",
 		Attributes: map[string]schema.Attribute{
@@ -80,30 +92,67 @@ func (r *dnsARecordResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							Description: "The IPv4 address this record will point to.",
 						},
 						"ttl": schema.Int32Attribute{
-							Optional:    true,
-							Description: "Time-to-live (TTL) for the record, in seconds.",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
 						},
 						"comments": schema.StringAttribute{
-							Optional:    true,
-							Description: "Comments for the record.",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
 						},
 					},
 				},
 			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsARecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRecordDefaults(ctx, r.providerData, path.Root("records"), req, resp)
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *dnsARecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan dnsARecordModel
+	var plan dnsARecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := r.createARecordCreateUpdateRequest(plan)
 
 	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
@@ -128,19 +177,31 @@ func (r *dnsARecordResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsARecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 
 	// Get current state
-	var state dnsARecordModel
+	var state dnsARecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the zone details from Abion API
 	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
 	if err != nil {
@@ -177,7 +238,7 @@ func (r *dnsARecordResource) Read(ctx context.Context, req resource.ReadRequest,
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dnsARecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan dnsARecordModel
+	var plan dnsARecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -185,13 +246,28 @@ func (r *dnsARecordResource) Update(ctx context.Context, req resource.UpdateRequ
 	}
 
 	// Retrieve values from current state
-	var state dnsARecordModel
+	var state dnsARecordResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// update or add the new records according to the plan
 	patchRequest := r.createARecordCreateUpdateRequest(plan)
 
@@ -225,18 +301,36 @@ func (r *dnsARecordResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dnsARecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state dnsARecordModel
+	var state dnsARecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), r.recordType, nil)
 
 	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
@@ -260,7 +354,7 @@ func (r *dnsARecordResource) ImportState(ctx context.Context, req resource.Impor
 	importState(ctx, req, resp)
 }
 
-func (r *dnsARecordResource) createARecordCreateUpdateRequest(plan dnsARecordModel) abionclient.ZoneRequest {
+func (r *dnsARecordResource) createARecordCreateUpdateRequest(plan dnsARecordResourceModel) abionclient.ZoneRequest {
 	var data []abionclient.Record
 	for _, record := range plan.Records {
 		record := abionclient.Record{
@@ -152,3 +152,38 @@ func TestAccDnsARecordNonExistingZoneResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDnsARecordWaitForConsistencyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and wait until the record is visible in the zone
+			{
+				Config: providerConfig + `
+			resource "abion_dns_a_record" "test" {
+			  zone  = "pmapitest1.com"
+			  name = "consistency"
+			  records = [
+				{
+				  ip_address = "203.0.113.0"
+				},
+			  ]
+			  wait_for_consistency = true
+			}
+
+			data "abion_dns_a_record" "test_data" {
+			  zone = abion_dns_a_record.test.zone
+			  name = abion_dns_a_record.test.name
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_a_record.test", "wait_for_consistency", "true"),
+					resource.TestCheckResourceAttr("data.abion_dns_a_record.test_data", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.abion_dns_a_record.test_data", "records.0.ip_address", "203.0.113.0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
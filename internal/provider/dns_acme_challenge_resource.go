@@ -0,0 +1,478 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// acmeChallengeLabel is the label of the TXT records of the ACME DNS-01 challenge, see RFC 8555 section 8.4.
+const acmeChallengeLabel = "_acme-challenge"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsAcmeChallengeResource{}
+	_ resource.ResourceWithConfigure      = &dnsAcmeChallengeResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsAcmeChallengeResource{}
+	_ resource.ResourceWithValidateConfig = &dnsAcmeChallengeResource{}
+)
+
+// NewDnsAcmeChallengeResource is a helper function to simplify the provider implementation.
+func NewDnsAcmeChallengeResource() resource.Resource {
+	return &dnsAcmeChallengeResource{}
+}
+
+// dnsAcmeChallengeResource is the resource implementation.
+type dnsAcmeChallengeResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsAcmeChallengeResourceModel maps the resource schema data.
+type dnsAcmeChallengeResourceModel struct {
+	Domain             types.String   `tfsdk:"domain"`
+	Values             []types.String `tfsdk:"values"`
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	FQDN               types.String   `tfsdk:"fqdn"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsAcmeChallengeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsAcmeChallengeResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_acme_challenge"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsAcmeChallengeResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to publish the TXT records of an ACME DNS-01 challenge at the " +
+			"`_acme-challenge` name of a domain. The values are added to the TXT records of the name without touching " +
+			"other values, so that concurrent challenges of the same name can coexist, and only the values of the " +
+			"resource are removed when it is destroyed.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The domain to validate, e.g. `www.example.com`. The challenge of a wildcard domain " +
+					"such as `*.example.com` is published at the name of the base domain.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(domainRegexp, "must be a fully qualified domain name"),
+				},
+			},
+			"values": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The TXT values of the challenge, i.e. the base64url encoded SHA-256 digests of the key authorizations.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(acmeDigestRegexp, "must be a base64url encoded SHA-256 digest"),
+					),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The zone to publish the challenge in. Defaults to the zone of the account with the " +
+					"longest name that the challenge name is within.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The name of the TXT records relative to the zone, e.g. `_acme-challenge.www`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"fqdn": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fully qualified name of the TXT records, e.g. `_acme-challenge.www.example.com.`.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the records, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the records. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
+				MarkdownDescription: "Wait for the values to become visible in the zone before finishing create and update. " +
+					"Defaults to `true`, regardless of the `wait_for_consistency` setting of the provider, as the " +
+					"challenge can only be validated once it is visible.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that the challenge name is within the configured zone.
+func (r *dnsAcmeChallengeResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var domain, zone types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("domain"), &domain)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are verified when the resource is applied
+	if domain.IsNull() || domain.IsUnknown() || zone.IsNull() || zone.IsUnknown() {
+		return
+	}
+
+	fqdn := acmeChallengeFqdn(domain.ValueString())
+	if _, ok := relativeRecordName(fqdn, zone.ValueString()); !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone"),
+			"Challenge not within zone",
+			"The challenge name "+fqdn+" is not within the zone "+zone.ValueString()+".",
+		)
+	}
+}
+
+// ModifyPlan plans the record defaults and the challenge name, and validates the planned changes against the
+// allowed_zones and read_only settings of the provider.
+func (r *dnsAcmeChallengeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		var domain, zone types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("domain"), &domain)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !domain.IsUnknown() {
+			fqdn := acmeChallengeFqdn(domain.ValueString())
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("fqdn"), fqdn+".")...)
+
+			// The name of a looked up zone is planned when the resource is applied
+			if !zone.IsUnknown() {
+				if name, ok := relativeRecordName(fqdn, zone.ValueString()); ok {
+					resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), name)...)
+				}
+			}
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create publishes the values of the challenge and sets the initial Terraform state.
+func (r *dnsAcmeChallengeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsAcmeChallengeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	fqdn := acmeChallengeFqdn(plan.Domain.ValueString())
+
+	// Look up the zone of the challenge name if not configured
+	if plan.Zone.IsUnknown() || plan.Zone.IsNull() {
+		zone, err := lookupZone(ctx, r.client, fqdn)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Zones from Abion API",
+				err.Error(),
+			)
+			return
+		}
+		if zone == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("domain"),
+				"Zone not found",
+				"None of the zones of the account contains the challenge name "+fqdn+". Configure the zone explicitly if the name is in a zone of another account.",
+			)
+			return
+		}
+		plan.Zone = types.StringValue(zone)
+	}
+
+	name, ok := relativeRecordName(fqdn, plan.Zone.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("zone"),
+			"Challenge not within zone",
+			"The challenge name "+fqdn+" is not within the zone "+plan.Zone.ValueString()+".",
+		)
+		return
+	}
+	plan.Name = types.StringValue(name)
+
+	resp.Diagnostics.Append(r.update(ctx, plan, nil, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the values of the challenge that are still published.
+func (r *dnsAcmeChallengeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsAcmeChallengeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := zone.Data.Attributes.Records[state.Name.ValueString()][utils.RecordTypeTXT.String()]
+
+	var values []types.String
+	for _, value := range state.Values {
+		i := findRecordMember(records, value.ValueString())
+		if i < 0 {
+			continue
+		}
+		if len(values) == 0 {
+			state.TTL = utils.IntPointerToInt32(records[i].TTL)
+			state.Comments = utils.StringPointerToTerraformString(records[i].Comments)
+		}
+		values = append(values, value)
+	}
+
+	// All values have been removed outside of Terraform
+	if len(values) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.Values = values
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the values of the challenge and sets the updated Terraform state on success.
+func (r *dnsAcmeChallengeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsAcmeChallengeResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state dnsAcmeChallengeResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Remove the values that are no longer planned
+	var removed []string
+	for _, value := range state.Values {
+		if !slices.Contains(plan.Values, value) {
+			removed = append(removed, value.ValueString())
+		}
+	}
+
+	resp.Diagnostics.Append(r.update(ctx, plan, removed, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the values of the challenge and removes the Terraform state on success.
+func (r *dnsAcmeChallengeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsAcmeChallengeResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	tflog.Debug(ctx, "Deleting ACME challenge")
+
+	var values []string
+	for _, value := range state.Values {
+		values = append(values, value.ValueString())
+	}
+
+	_, err := updateRecordMembers(ctx, r.client, state.Zone.ValueString(), state.Name.ValueString(), utils.RecordTypeTXT, nil, values)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete ACME challenge, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// update publishes the planned values, removes the removed values and waits for the changes to become visible.
+func (r *dnsAcmeChallengeResource) update(ctx context.Context, plan dnsAcmeChallengeResourceModel, removed []string, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Publishing ACME challenge")
+
+	var values []string
+	var records []abionclient.Record
+	for _, value := range plan.Values {
+		values = append(values, value.ValueString())
+		records = append(records, abionclient.Record{
+			Data:     value.ValueString(),
+			TTL:      utils.Int32ToIntPointer(plan.TTL),
+			Comments: plan.Comments.ValueStringPointer(),
+		})
+	}
+
+	_, err := updateRecordMembers(ctx, r.client, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypeTXT, records, removed)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" ACME challenge, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the values to become visible in the zone, ignoring concurrent challenges of the same name
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypeTXT, values, removed)...)
+
+	return diags
+}
+
+// acmeChallengeFqdn returns the fully qualified challenge name of the domain, without a trailing dot. The challenge of
+// a wildcard domain is published at the name of its base domain.
+func acmeChallengeFqdn(domain string) string {
+	domain = strings.TrimPrefix(normalizeZoneName(domain), "*.")
+	return acmeChallengeLabel + "." + domain
+}
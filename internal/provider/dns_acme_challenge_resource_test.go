@@ -0,0 +1,77 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcmeChallengeFqdn(t *testing.T) {
+	tests := map[string]string{
+		"www.example.com":   "_acme-challenge.www.example.com",
+		"*.Example.com.":    "_acme-challenge.example.com",
+		"*.www.example.com": "_acme-challenge.www.example.com",
+	}
+
+	for domain, expected := range tests {
+		if actual := acmeChallengeFqdn(domain); actual != expected {
+			t.Errorf("acmeChallengeFqdn(%s): expected %s, got %s", domain, expected, actual)
+		}
+	}
+}
+
+func TestAccDnsAcmeChallengeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, two concurrent challenges of the same name
+			{
+				Config: providerConfig + `
+			resource "abion_dns_acme_challenge" "test" {
+			  domain = "acme.pmapitest1.com"
+			  values = ["LoqXcYV8q5ONbJQxbmR7SCTNo3tiAXDfowyjxAjEuX0"]
+			}
+
+			resource "abion_dns_acme_challenge" "wildcard" {
+			  domain = "*.acme.pmapitest1.com"
+			  zone   = "pmapitest1.com"
+			  values = ["2hEpyKTRk8U7cJbZXuy0VgWjuB-l2zLJtyTHUjLgKCk"]
+			  ttl    = 60
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.test", "zone", "pmapitest1.com"),
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.test", "name", "_acme-challenge.acme"),
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.test", "fqdn", "_acme-challenge.acme.pmapitest1.com."),
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.test", "values.#", "1"),
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.wildcard", "name", "_acme-challenge.acme"),
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.wildcard", "ttl", "60"),
+				),
+			},
+			// Update and Read testing, remove one challenge and update the values of the other
+			{
+				Config: providerConfig + `
+			resource "abion_dns_acme_challenge" "wildcard" {
+			  domain = "*.acme.pmapitest1.com"
+			  zone   = "pmapitest1.com"
+			  values = [
+			    "2hEpyKTRk8U7cJbZXuy0VgWjuB-l2zLJtyTHUjLgKCk",
+			    "7R8a0dGfBx1vRNE3M2cTe4qzJ5AvuHqnNb-YbX0Lp9w",
+			  ]
+			  ttl    = 60
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_acme_challenge.wildcard", "values.#", "2"),
+					resource.TestCheckTypeSetElemAttr("abion_dns_acme_challenge.wildcard", "values.*", "7R8a0dGfBx1vRNE3M2cTe4qzJ5AvuHqnNb-YbX0Lp9w"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,835 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsCAAPolicyResource{}
+	_ resource.ResourceWithConfigure      = &dnsCAAPolicyResource{}
+	_ resource.ResourceWithImportState    = &dnsCAAPolicyResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsCAAPolicyResource{}
+	_ resource.ResourceWithValidateConfig = &dnsCAAPolicyResource{}
+)
+
+// CAA property tags managed by the policy resource.
+const (
+	caaTagIssue     = "issue"
+	caaTagIssueWild = "issuewild"
+	caaTagIodef     = "iodef"
+)
+
+// caaCriticalFlag is the issuer critical flag of a CAA record.
+const caaCriticalFlag = 128
+
+// RFC 8657 parameters of the issue and issuewild properties.
+const (
+	caaAccountURIParameter        = "accounturi"
+	caaValidationMethodsParameter = "validationmethods"
+)
+
+// caaParameterTagRegexp matches the tag of an issuer parameter.
+var caaParameterTagRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+$`)
+
+// caaParameterValueRegexp matches the value of an issuer parameter, which must not contain whitespace, semicolons or
+// quotes.
+var caaParameterValueRegexp = regexp.MustCompile(`^[\x21\x23-\x3a\x3c-\x7e]+$`)
+
+// caaAccountURIRegexp matches the URI of an account of a certificate authority, e.g.
+// https://acme-v02.api.letsencrypt.org/acme/acct/123456.
+var caaAccountURIRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*:[\x21\x23-\x2b\x2d-\x3a\x3c-\x7e]+$`)
+
+// caaValidationMethodRegexp matches a validation method, an ACME challenge type such as dns-01 or a method prefixed
+// with ca- that is specific to the certificate authority.
+var caaValidationMethodRegexp = regexp.MustCompile(`^[a-zA-Z0-9]+(-+[a-zA-Z0-9]+)*$`)
+
+// caaIodefURLRegexp matches the URL incident reports are sent to, a mailto, http or https URL.
+var caaIodefURLRegexp = regexp.MustCompile(`^(mailto:[^\s"@]+@[^\s"@]+|https?://[^\s"]+)$`)
+
+// NewDnsCAAPolicyResource is a helper function to simplify the provider implementation.
+func NewDnsCAAPolicyResource() resource.Resource {
+	return &dnsCAAPolicyResource{}
+}
+
+// dnsCAAPolicyResource is the resource implementation.
+type dnsCAAPolicyResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsCAAPolicyResourceModel maps the resource schema data.
+type dnsCAAPolicyResourceModel struct {
+	Zone               types.String     `tfsdk:"zone"`
+	Name               types.String     `tfsdk:"name"`
+	Issue              []caaIssuerModel `tfsdk:"issue"`
+	IssueWild          []caaIssuerModel `tfsdk:"issuewild"`
+	Iodef              []caaIodefModel  `tfsdk:"iodef"`
+	Values             []types.String   `tfsdk:"values"`
+	TTL                types.Int32      `tfsdk:"ttl"`
+	Comments           types.String     `tfsdk:"comments"`
+	WaitForConsistency types.Bool       `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value   `tfsdk:"timeouts"`
+}
+
+// caaIssuerModel maps an issue or issuewild block.
+type caaIssuerModel struct {
+	Issuer            types.String            `tfsdk:"issuer"`
+	AccountURI        types.String            `tfsdk:"account_uri"`
+	ValidationMethods []types.String          `tfsdk:"validation_methods"`
+	Parameters        map[string]types.String `tfsdk:"parameters"`
+	Critical          types.Bool              `tfsdk:"critical"`
+}
+
+// caaIodefModel maps an iodef block.
+type caaIodefModel struct {
+	URL      types.String `tfsdk:"url"`
+	Critical types.Bool   `tfsdk:"critical"`
+}
+
+// caaProperty is a property of a CAA record.
+type caaProperty struct {
+	Critical bool
+	Tag      string
+	Value    string
+}
+
+// caaIssuer is the value of an issue or issuewild property. An empty issuer forbids issuance.
+type caaIssuer struct {
+	Issuer            string
+	AccountURI        string
+	ValidationMethods []string
+	Parameters        map[string]string
+}
+
+func (r *dnsCAAPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsCAAPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_caa_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsCAAPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	critical := schema.BoolAttribute{
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+		MarkdownDescription: "Whether the property is critical, i.e. certificate authorities that don't understand it " +
+			"must not issue certificates. Defaults to `false`.",
+	}
+
+	issuer := func(description string) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			MarkdownDescription: description,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"issuer": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The domain of the certificate authority, e.g. `letsencrypt.org`. Omit it " +
+							"to forbid issuance.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(hostnameRegexp, "must be a domain name"),
+						},
+					},
+					"account_uri": schema.StringAttribute{
+						Optional: true,
+						MarkdownDescription: "The URI of the account at the certificate authority that may request " +
+							"certificates, the `accounturi` parameter of RFC 8657.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(caaAccountURIRegexp, "must be a URI without whitespace, commas or semicolons"),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("issuer")),
+						},
+					},
+					"validation_methods": schema.ListAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						MarkdownDescription: "The domain validation methods the certificate authority may use, e.g. " +
+							"`dns-01` or `http-01`, the `validationmethods` parameter of RFC 8657.",
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(caaValidationMethodRegexp, "must be a validation method, e.g. dns-01"),
+							),
+							listvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("issuer")),
+						},
+					},
+					"parameters": schema.MapAttribute{
+						Optional:    true,
+						ElementType: types.StringType,
+						MarkdownDescription: "Other parameters of the certificate authority, by tag. Use `account_uri` " +
+							"and `validation_methods` for the parameters of RFC 8657.",
+						Validators: []validator.Map{
+							mapvalidator.SizeAtLeast(1),
+							mapvalidator.KeysAre(
+								stringvalidator.RegexMatches(caaParameterTagRegexp, "must be alphanumeric"),
+								stringvalidator.NoneOfCaseInsensitive(caaAccountURIParameter, caaValidationMethodsParameter),
+							),
+							mapvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(caaParameterValueRegexp, "must not contain whitespace, quotes or semicolons"),
+							),
+							mapvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("issuer")),
+						},
+					},
+					"critical": critical,
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the CAA policy of a domain, i.e. which certificate authorities " +
+			"may issue certificates for it. All CAA records of the name are managed by the resource, and destroying it " +
+			"removes every CAA record of the name, including those managed by `abion_dns_caa_record`, so the two " +
+			"resources must not be used for the same name. A warning is shown if the zone has wildcard names within " +
+			"the domain, but the policy has no `issuewild` blocks.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the records belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				MarkdownDescription: "The name of the domain, relative to the zone. Defaults to `@`, the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The data of the CAA records, e.g. `0 issue \"letsencrypt.org\"`.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the records, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the records. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			caaTagIssue: issuer("The certificate authorities that may issue certificates for the domain, and wildcard " +
+				"certificates too unless there are `issuewild` blocks."),
+			caaTagIssueWild: issuer("The certificate authorities that may issue wildcard certificates for the domain."),
+			caaTagIodef: schema.ListNestedBlock{
+				MarkdownDescription: "The URLs certificate authorities report requests that violate the policy to.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The `mailto`, `http` or `https` URL to report to, e.g. `mailto:security@example.com`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(caaIodefURLRegexp, "must be a mailto, http or https URL"),
+							},
+						},
+						"critical": critical,
+					},
+				},
+			},
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that the policy has at least one property.
+func (r *dnsCAAPolicyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Unknown values are verified when the resource is planned
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var config dnsCAAPolicyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(config.Issue) == 0 && len(config.IssueWild) == 0 && len(config.Iodef) == 0 {
+		resp.Diagnostics.AddError(
+			"Missing CAA property",
+			"The policy must have at least one issue, issuewild or iodef block.",
+		)
+	}
+}
+
+// ModifyPlan plans the record defaults and the data of the records, validates the planned changes against the
+// allowed_zones and read_only settings of the provider and warns about wildcard names of the domain the policy has no
+// issuewild properties for.
+func (r *dnsCAAPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		// The data is unknown until all properties are known
+		if req.Config.Raw.IsFullyKnown() {
+			// The planned values list is unknown, so the properties are taken from the configuration
+			var config dnsCAAPolicyResourceModel
+			resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("values"), caaPropertiesData(config.properties()))...)
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+
+	// The wildcard names are only looked up if the zone may be changed
+	if req.Plan.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() || r.providerData == nil || resp.Diagnostics.HasError() {
+		return
+	}
+
+	var config dnsCAAPolicyResourceModel
+	var name types.String
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("name"), &name)...)
+	if resp.Diagnostics.HasError() || len(config.IssueWild) > 0 {
+		return
+	}
+
+	// The lookup only serves the warning, so a zone that can't be read doesn't block the plan
+	zone, err := r.client.GetZone(ctx, config.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unable to Read Abion Zone",
+			"The wildcard names of the zone could not be looked up: "+err.Error(),
+		)
+		return
+	}
+
+	if wildcards := caaWildcardNames(*zone.Data, name.ValueString()); len(wildcards) > 0 {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(caaTagIssueWild),
+			"Missing issuewild property",
+			"The zone has the wildcard names "+strings.Join(wildcards, ", ")+" within the domain, but the "+
+				"policy has no issuewild blocks, so the issue blocks also govern the issuance of wildcard "+
+				"certificates. Add issuewild blocks to control wildcard certificates explicitly.",
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsCAAPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsCAAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsCAAPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsCAAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := zone.Data.Attributes.Records[state.Name.ValueString()][utils.RecordTypeCAA.String()]
+
+	// The records have been removed outside of Terraform
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var properties []caaProperty
+	var data []string
+	for _, record := range records {
+		data = append(data, record.Data)
+
+		property, err := parseCAAProperty(record.Data)
+		if err == nil {
+			err = validateCAAProperty(property)
+		}
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"Unsupported CAA record",
+				"The CAA record "+record.Data+" of "+state.Name.ValueString()+" can't be represented by the "+
+					"resource and is replaced when the policy is applied: "+err.Error(),
+			)
+			properties = nil
+			break
+		}
+		properties = append(properties, property)
+	}
+
+	// The properties of records that can't be represented are kept as is, and the data shows the drift
+	if properties != nil {
+		state.setProperties(properties)
+		state.Values = utils.StringsToTerraformStrings(caaPropertiesData(properties))
+	} else {
+		state.Values = utils.StringsToTerraformStrings(data)
+	}
+	state.TTL = utils.IntPointerToInt32(records[0].TTL)
+	state.Comments = utils.StringPointerToTerraformString(records[0].Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsCAAPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsCAAPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsCAAPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsCAAPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), utils.RecordTypeCAA, nil)
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	tflog.Debug(ctx, "Deleting CAA records")
+
+	// Update zone by removing the records
+	_, err := r.client.PatchZone(ctx, state.Zone.ValueString(), patchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete CAA records, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the CAA policy of the domain given by the import ID.
+func (r *dnsCAAPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+// put replaces the CAA records of the name with the records of the plan and waits for the change to become visible.
+func (r *dnsCAAPolicyResource) put(ctx context.Context, plan dnsCAAPolicyResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var records []abionclient.Record
+	for _, data := range utils.TerraformStringsToStrings(plan.Values) {
+		records = append(records, abionclient.Record{
+			Data:     data,
+			TTL:      utils.Int32ToIntPointer(plan.TTL),
+			Comments: plan.Comments.ValueStringPointer(),
+		})
+	}
+
+	patchRequest := abionclient.CreateRecordPatchRequest(plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypeCAA, records)
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Setting CAA records")
+
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" CAA records, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the records to become visible in the zone
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypeCAA, utils.TerraformStringsToStrings(plan.Values), nil)...)
+
+	return diags
+}
+
+// properties returns the CAA properties of the blocks of the model, in the order issue, issuewild and iodef.
+func (m dnsCAAPolicyResourceModel) properties() []caaProperty {
+	var properties []caaProperty
+
+	for _, tag := range []string{caaTagIssue, caaTagIssueWild} {
+		blocks := m.Issue
+		if tag == caaTagIssueWild {
+			blocks = m.IssueWild
+		}
+
+		for _, block := range blocks {
+			issuer := caaIssuer{
+				Issuer:            block.Issuer.ValueString(),
+				AccountURI:        block.AccountURI.ValueString(),
+				ValidationMethods: utils.TerraformStringsToStrings(block.ValidationMethods),
+			}
+			if len(block.Parameters) > 0 {
+				issuer.Parameters = make(map[string]string, len(block.Parameters))
+				for tag, value := range block.Parameters {
+					issuer.Parameters[tag] = value.ValueString()
+				}
+			}
+
+			properties = append(properties, caaProperty{
+				Critical: block.Critical.ValueBool(),
+				Tag:      tag,
+				Value:    issuer.String(),
+			})
+		}
+	}
+
+	for _, block := range m.Iodef {
+		properties = append(properties, caaProperty{
+			Critical: block.Critical.ValueBool(),
+			Tag:      caaTagIodef,
+			Value:    block.URL.ValueString(),
+		})
+	}
+
+	return properties
+}
+
+// setProperties sets the blocks of the model to the CAA properties, which must be valid.
+func (m *dnsCAAPolicyResourceModel) setProperties(properties []caaProperty) {
+	m.Issue = []caaIssuerModel{}
+	m.IssueWild = []caaIssuerModel{}
+	m.Iodef = []caaIodefModel{}
+
+	for _, property := range properties {
+		switch property.Tag {
+		case caaTagIssue, caaTagIssueWild:
+			issuer, _ := parseCAAIssuer(property.Value)
+
+			block := caaIssuerModel{
+				Issuer:            utils.StringToTerraformString(issuer.Issuer),
+				AccountURI:        utils.StringToTerraformString(issuer.AccountURI),
+				ValidationMethods: utils.StringsToTerraformStrings(issuer.ValidationMethods),
+				Critical:          types.BoolValue(property.Critical),
+			}
+			if len(issuer.Parameters) > 0 {
+				block.Parameters = make(map[string]types.String, len(issuer.Parameters))
+				for tag, value := range issuer.Parameters {
+					block.Parameters[tag] = types.StringValue(value)
+				}
+			}
+
+			if property.Tag == caaTagIssue {
+				m.Issue = append(m.Issue, block)
+			} else {
+				m.IssueWild = append(m.IssueWild, block)
+			}
+		case caaTagIodef:
+			m.Iodef = append(m.Iodef, caaIodefModel{
+				URL:      types.StringValue(property.Value),
+				Critical: types.BoolValue(property.Critical),
+			})
+		}
+	}
+}
+
+// String returns the record data of the CAA property, e.g. 0 issue "letsencrypt.org".
+func (p caaProperty) String() string {
+	flag := 0
+	if p.Critical {
+		flag = caaCriticalFlag
+	}
+	return strconv.Itoa(flag) + " " + p.Tag + " \"" + p.Value + "\""
+}
+
+// String returns the value of the issue or issuewild property of the issuer. The RFC 8657 parameters come first,
+// followed by other parameters sorted by tag.
+func (i caaIssuer) String() string {
+	var parameters []string
+	if i.AccountURI != "" {
+		parameters = append(parameters, caaAccountURIParameter+"="+i.AccountURI)
+	}
+	if len(i.ValidationMethods) > 0 {
+		parameters = append(parameters, caaValidationMethodsParameter+"="+strings.Join(i.ValidationMethods, ","))
+	}
+
+	tags := make([]string, 0, len(i.Parameters))
+	for tag := range i.Parameters {
+		tags = append(tags, tag)
+	}
+	sort.Strings(tags)
+	for _, tag := range tags {
+		parameters = append(parameters, tag+"="+i.Parameters[tag])
+	}
+
+	if len(parameters) == 0 {
+		if i.Issuer == "" {
+			return ";"
+		}
+		return i.Issuer
+	}
+	return i.Issuer + "; " + strings.Join(parameters, "; ")
+}
+
+// caaPropertiesData returns the record data of the CAA properties.
+func caaPropertiesData(properties []caaProperty) []string {
+	var data []string
+	for _, property := range properties {
+		data = append(data, property.String())
+	}
+	return data
+}
+
+// parseCAAProperty parses the record data of a CAA property, e.g. 0 issue "letsencrypt.org".
+func parseCAAProperty(data string) (caaProperty, error) {
+	var property caaProperty
+
+	flag, rest, _ := strings.Cut(strings.TrimSpace(data), " ")
+	tag, value, found := strings.Cut(strings.TrimSpace(rest), " ")
+	if !found {
+		return property, fmt.Errorf("the record doesn't have the format flag tag value")
+	}
+
+	switch flag {
+	case "0":
+	case strconv.Itoa(caaCriticalFlag):
+		property.Critical = true
+	default:
+		return property, fmt.Errorf("the flag %s is not supported", flag)
+	}
+
+	value = strings.TrimSpace(value)
+	if len(value) >= 2 && strings.HasPrefix(value, "\"") && strings.HasSuffix(value, "\"") {
+		value = value[1 : len(value)-1]
+	}
+
+	property.Tag = strings.ToLower(tag)
+	property.Value = value
+
+	return property, nil
+}
+
+// validateCAAProperty verifies that the CAA property can be represented by the blocks of the resource.
+func validateCAAProperty(property caaProperty) error {
+	switch property.Tag {
+	case caaTagIssue, caaTagIssueWild:
+		_, err := parseCAAIssuer(property.Value)
+		return err
+	case caaTagIodef:
+		if !caaIodefURLRegexp.MatchString(property.Value) {
+			return fmt.Errorf("the iodef URL %s is not a mailto, http or https URL", property.Value)
+		}
+		return nil
+	default:
+		return fmt.Errorf("the property %s is not supported", property.Tag)
+	}
+}
+
+// parseCAAIssuer parses the value of an issue or issuewild property.
+func parseCAAIssuer(value string) (caaIssuer, error) {
+	var issuer caaIssuer
+
+	parts := strings.Split(value, ";")
+	issuer.Issuer = strings.TrimSpace(parts[0])
+	if issuer.Issuer != "" && !hostnameRegexp.MatchString(issuer.Issuer) {
+		return issuer, fmt.Errorf("the issuer %s is not a domain name", issuer.Issuer)
+	}
+
+	for _, parameter := range parts[1:] {
+		parameter = strings.TrimSpace(parameter)
+		if parameter == "" {
+			continue
+		}
+
+		tag, value, found := strings.Cut(parameter, "=")
+		tag, value = strings.TrimSpace(tag), strings.TrimSpace(value)
+		if !found || !caaParameterTagRegexp.MatchString(tag) || !caaParameterValueRegexp.MatchString(value) {
+			return issuer, fmt.Errorf("the parameter %s is not a tag=value pair", parameter)
+		}
+		if issuer.Issuer == "" {
+			return issuer, fmt.Errorf("the parameter %s has no issuer", parameter)
+		}
+
+		switch strings.ToLower(tag) {
+		case caaAccountURIParameter:
+			if issuer.AccountURI != "" || !caaAccountURIRegexp.MatchString(value) {
+				return issuer, fmt.Errorf("the parameter %s is not a single account URI", parameter)
+			}
+			issuer.AccountURI = value
+		case caaValidationMethodsParameter:
+			if issuer.ValidationMethods != nil {
+				return issuer, fmt.Errorf("the parameter %s is repeated", tag)
+			}
+			issuer.ValidationMethods = splitTagValue(value, ",")
+			for _, method := range issuer.ValidationMethods {
+				if !caaValidationMethodRegexp.MatchString(method) {
+					return issuer, fmt.Errorf("the validation method %s is not valid", method)
+				}
+			}
+		default:
+			if _, exists := issuer.Parameters[tag]; exists {
+				return issuer, fmt.Errorf("the parameter %s is repeated", tag)
+			}
+			if issuer.Parameters == nil {
+				issuer.Parameters = make(map[string]string)
+			}
+			issuer.Parameters[tag] = value
+		}
+	}
+
+	return issuer, nil
+}
+
+// caaWildcardNames returns the wildcard names of the zone within the domain of the name, sorted.
+func caaWildcardNames(zone abionclient.Zone, name string) []string {
+	var wildcards []string
+	for recordName := range zone.Attributes.Records {
+		if !strings.HasPrefix(recordName, "*") {
+			continue
+		}
+		if name == "@" || strings.HasSuffix(recordName, "."+name) {
+			wildcards = append(wildcards, recordName)
+		}
+	}
+	sort.Strings(wildcards)
+	return wildcards
+}
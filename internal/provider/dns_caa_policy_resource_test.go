@@ -0,0 +1,184 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+func TestCAAIssuer(t *testing.T) {
+	issuer := caaIssuer{
+		Issuer:            "letsencrypt.org",
+		AccountURI:        "https://acme-v02.api.letsencrypt.org/acme/acct/123456",
+		ValidationMethods: []string{"dns-01", "http-01"},
+		Parameters:        map[string]string{"policy": "ev", "cansignhttpexchanges": "yes"},
+	}
+
+	expected := "letsencrypt.org; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/123456; " +
+		"validationmethods=dns-01,http-01; cansignhttpexchanges=yes; policy=ev"
+	if value := issuer.String(); value != expected {
+		t.Errorf("unexpected value %s", value)
+	}
+
+	parsed, err := parseCAAIssuer(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, issuer) {
+		t.Errorf("unexpected issuer %+v", parsed)
+	}
+
+	if value := (caaIssuer{}).String(); value != ";" {
+		t.Errorf("unexpected value of an empty issuer %s", value)
+	}
+	if parsed, err := parseCAAIssuer(";"); err != nil || parsed.Issuer != "" {
+		t.Errorf("unexpected issuer %+v, %v", parsed, err)
+	}
+
+	for _, value := range []string{"not a domain", "; accounturi=https://ca.example/1", "ca.example; policy", "ca.example; validationmethods=dns_01"} {
+		if _, err := parseCAAIssuer(value); err == nil {
+			t.Errorf("expected an error for %q", value)
+		}
+	}
+}
+
+func TestCAAProperty(t *testing.T) {
+	property, err := parseCAAProperty(`128 issue "letsencrypt.org; validationmethods=dns-01"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !property.Critical || property.Tag != caaTagIssue || property.Value != "letsencrypt.org; validationmethods=dns-01" {
+		t.Errorf("unexpected property %+v", property)
+	}
+	if data := property.String(); data != `128 issue "letsencrypt.org; validationmethods=dns-01"` {
+		t.Errorf("unexpected data %s", data)
+	}
+
+	if property, err := parseCAAProperty(`0 iodef "mailto:security@example.com"`); err != nil || validateCAAProperty(property) != nil {
+		t.Errorf("unexpected error for an iodef property: %v", err)
+	}
+
+	for _, data := range []string{`0 issue`, `1 issue "letsencrypt.org"`, `0 contactemail "security@example.com"`, `0 iodef "ftp://example.com"`} {
+		property, err := parseCAAProperty(data)
+		if err == nil {
+			err = validateCAAProperty(property)
+		}
+		if err == nil {
+			t.Errorf("expected an error for %q", data)
+		}
+	}
+}
+
+func TestCAAWildcardNames(t *testing.T) {
+	zone := abionclient.Zone{Attributes: abionclient.Attributes{
+		Records: map[string]map[string][]abionclient.Record{
+			"@":         {},
+			"*":         {},
+			"*.shop":    {},
+			"*.eu.shop": {},
+			"*.xshop":   {},
+			"www":       {},
+		},
+	}}
+
+	if names := caaWildcardNames(zone, "@"); !reflect.DeepEqual(names, []string{"*", "*.eu.shop", "*.shop", "*.xshop"}) {
+		t.Errorf("unexpected wildcard names %v", names)
+	}
+	if names := caaWildcardNames(zone, "shop"); !reflect.DeepEqual(names, []string{"*.eu.shop", "*.shop"}) {
+		t.Errorf("unexpected wildcard names %v", names)
+	}
+	if names := caaWildcardNames(zone, "www"); len(names) != 0 {
+		t.Errorf("unexpected wildcard names %v", names)
+	}
+}
+
+func TestAccDnsCAAPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_caa_policy" "test" {
+			  zone = "pmapitest1.com"
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Missing CAA property`),
+			},
+			{
+				Config: providerConfig + `
+			resource "abion_dns_caa_policy" "test" {
+			  zone = "pmapitest1.com"
+			  issue {
+			    validation_methods = ["dns-01"]
+			  }
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_caa_policy" "test" {
+			  zone = "pmapitest1.com"
+			  name = "caa"
+			  issue {
+			    issuer             = "letsencrypt.org"
+			    validation_methods = ["dns-01"]
+			  }
+			  issuewild {
+			  }
+			  iodef {
+			    url = "mailto:security@pmapitest1.com"
+			  }
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.#", "3"),
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.0", `0 issue "letsencrypt.org; validationmethods=dns-01"`),
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.1", `0 issuewild ";"`),
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.2", `0 iodef "mailto:security@pmapitest1.com"`),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_caa_policy.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/caa",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_caa_policy" "test" {
+			  zone = "pmapitest1.com"
+			  name = "caa"
+			  issue {
+			    issuer      = "letsencrypt.org"
+			    account_uri = "https://acme-v02.api.letsencrypt.org/acme/acct/123456"
+			    critical    = true
+			  }
+			  issue {
+			    issuer = "sectigo.com"
+			  }
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.#", "2"),
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "values.0", `128 issue "letsencrypt.org; accounturi=https://acme-v02.api.letsencrypt.org/acme/acct/123456"`),
+					resource.TestCheckResourceAttr("abion_dns_caa_policy.test", "issuewild.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
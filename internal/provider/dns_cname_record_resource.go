@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -19,6 +21,7 @@ var (
 	_ resource.Resource                = &dnsCNameRecordResource{}
 	_ resource.ResourceWithConfigure   = &dnsCNameRecordResource{}
 	_ resource.ResourceWithImportState = &dnsCNameRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsCNameRecordResource{}
 )
 
 // NewDnsCNameRecordResource is a helper function to simplify the provider implementation.
@@ -28,8 +31,16 @@ func NewDnsCNameRecordResource() resource.Resource {
 
 // dnsCNameRecordResource is the resource implementation.
 type dnsCNameRecordResource struct {
-	client     *abionclient.Client
-	recordType utils.RecordType
+	client       *abionclient.Client
+	providerData *AbionProviderData
+	recordType   utils.RecordType
+}
+
+// dnsCNameRecordResourceModel maps the resource schema data.
+type dnsCNameRecordResourceModel struct {
+	dnsCNameRecordModel
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dnsCNameRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -39,16 +50,17 @@ func (r *dnsCNameRecordResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*abionclient.Client)
+	providerData, ok := req.ProviderData.(*AbionProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *abionclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
 	r.recordType = utils.RecordTypeCName
 }
 
@@ -58,7 +70,7 @@ func (r *dnsCNameRecordResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *dnsCNameRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *dnsCNameRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this resource to create, update and delete DNS CNAME records of a zone.",
 		Attributes: map[string]schema.Attribute{
@@ -79,29 +91,66 @@ func (r *dnsCNameRecordResource) Schema(_ context.Context, _ resource.SchemaRequ
 						Required:    true,
 					},
 					"ttl": schema.Int32Attribute{
-						Description: "Time-to-live (TTL) for the record, in seconds.",
-						Optional:    true,
+						MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+						Optional:            true,
+						Computed:            true,
 					},
 					"comments": schema.StringAttribute{
-						Description: "Comments for the record.",
-						Optional:    true,
+						MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+						Optional:            true,
+						Computed:            true,
 					},
 				},
 			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsCNameRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanSingleRecordDefaults(ctx, r.providerData, path.Root("record"), req, resp)
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *dnsCNameRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan dnsCNameRecordModel
+	var plan dnsCNameRecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := r.createARecordCreateUpdateRequest(plan)
 
 	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
@@ -126,19 +175,31 @@ func (r *dnsCNameRecordResource) Create(ctx context.Context, req resource.Create
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsCNameRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 
 	// Get current state
-	var state dnsCNameRecordModel
+	var state dnsCNameRecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the zone details from Abion API
 	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
 	if err != nil {
@@ -175,7 +236,7 @@ func (r *dnsCNameRecordResource) Read(ctx context.Context, req resource.ReadRequ
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dnsCNameRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan dnsCNameRecordModel
+	var plan dnsCNameRecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -183,13 +244,28 @@ func (r *dnsCNameRecordResource) Update(ctx context.Context, req resource.Update
 	}
 
 	// Retrieve values from current state
-	var state dnsCNameRecordModel
+	var state dnsCNameRecordResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := r.createARecordCreateUpdateRequest(plan)
 
 	if plan.Name.ValueString() != state.Name.ValueString() {
@@ -222,18 +298,36 @@ func (r *dnsCNameRecordResource) Update(ctx context.Context, req resource.Update
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dnsCNameRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state dnsCNameRecordModel
+	var state dnsCNameRecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), r.recordType, nil)
 
 	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
@@ -257,7 +351,7 @@ func (r *dnsCNameRecordResource) ImportState(ctx context.Context, req resource.I
 	importState(ctx, req, resp)
 }
 
-func (r *dnsCNameRecordResource) createARecordCreateUpdateRequest(plan dnsCNameRecordModel) abionclient.ZoneRequest {
+func (r *dnsCNameRecordResource) createARecordCreateUpdateRequest(plan dnsCNameRecordResourceModel) abionclient.ZoneRequest {
 
 	record := abionclient.Record{
 		Data:     plan.Record.CName.ValueString(),
@@ -0,0 +1,656 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsDelegationResource{}
+	_ resource.ResourceWithConfigure      = &dnsDelegationResource{}
+	_ resource.ResourceWithImportState    = &dnsDelegationResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsDelegationResource{}
+	_ resource.ResourceWithValidateConfig = &dnsDelegationResource{}
+)
+
+// NewDnsDelegationResource is a helper function to simplify the provider implementation.
+func NewDnsDelegationResource() resource.Resource {
+	return &dnsDelegationResource{}
+}
+
+// dnsDelegationResource is the resource implementation.
+type dnsDelegationResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsDelegationResourceModel maps the resource schema data.
+type dnsDelegationResourceModel struct {
+	Zone               types.String               `tfsdk:"zone"`
+	Name               types.String               `tfsdk:"name"`
+	Nameservers        []DelegationNameserverData `tfsdk:"nameservers"`
+	TTL                types.Int32                `tfsdk:"ttl"`
+	Comments           types.String               `tfsdk:"comments"`
+	WaitForConsistency types.Bool                 `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value             `tfsdk:"timeouts"`
+}
+
+type DelegationNameserverData struct {
+	Hostname      types.String   `tfsdk:"hostname"`
+	IPv4Addresses []types.String `tfsdk:"ipv4_addresses"`
+	IPv6Addresses []types.String `tfsdk:"ipv6_addresses"`
+}
+
+func (r *dnsDelegationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsDelegationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_delegation"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsDelegationResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to delegate a subdomain of a zone to other name servers. The NS records " +
+			"of the subdomain and the glue A and AAAA records of name servers within the subdomain are managed together. " +
+			"The plan fails if the glue of a name server is missing, or if the delegation conflicts with other records " +
+			"of the zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone to delegate the subdomain of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the subdomain relative to the zone, for example `team` or `team.east`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.NoneOf("@"),
+				},
+			},
+			"nameservers": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The name servers the subdomain is delegated to.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The fully qualified host name of the name server, e.g. `ns1.team.example.com.`.",
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(hostnameRegexp, "must be a fully qualified host name"),
+							},
+						},
+						"ipv4_addresses": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IPv4 glue addresses of the name server. Glue is required for, and only allowed for, name servers within the subdomain.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(ipAddressValidator{version: 4}),
+							},
+						},
+						"ipv6_addresses": schema.ListAttribute{
+							Optional:    true,
+							ElementType: types.StringType,
+							Description: "The IPv6 glue addresses of the name server. Glue is required for, and only allowed for, name servers within the subdomain.",
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(ipAddressValidator{version: 6}),
+							},
+						},
+					},
+				},
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the NS and glue records, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the NS and glue records. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that the glue of the name servers is complete.
+func (r *dnsDelegationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Unknown values are verified when the resource is planned
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var config dnsDelegationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateDelegationGlue(config)...)
+}
+
+// ModifyPlan plans the record defaults, validates the planned changes against the allowed_zones and read_only
+// settings of the provider and verifies that the delegation doesn't conflict with other records of the zone.
+func (r *dnsDelegationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+
+	// Conflicts are verified against the zone once the provider is configured, the zone may be changed and the plan
+	// is known. They are verified again when the delegation is applied, so plans without changes skip the lookup.
+	if req.Plan.Raw.IsNull() || r.providerData == nil || resp.Diagnostics.HasError() || !resp.Plan.Raw.IsFullyKnown() || resp.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	var plan dnsDelegationResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+
+	var state *dnsDelegationResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &dnsDelegationResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zone, err := r.client.GetZone(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(validateDelegationConflicts(*zone.Data, plan, state)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsDelegationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsDelegationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, nil, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsDelegationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsDelegationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := zone.Data.Attributes.Records
+	nsRecords := records[state.Name.ValueString()][utils.RecordTypeNS.String()]
+
+	// The delegation has been removed outside of Terraform
+	if len(nsRecords) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.TTL = utils.IntPointerToInt32(nsRecords[0].TTL)
+	state.Comments = utils.StringPointerToTerraformString(nsRecords[0].Comments)
+
+	// Keep the host names as configured if they only differ by case or the trailing dot
+	configured := make(map[string]types.String)
+	for _, nameserver := range state.Nameservers {
+		configured[normalizeZoneName(nameserver.Hostname.ValueString())] = nameserver.Hostname
+	}
+
+	fqdn := delegationFqdn(state.Zone.ValueString(), state.Name.ValueString())
+
+	state.Nameservers = []DelegationNameserverData{}
+	for _, record := range nsRecords {
+		hostname, ok := configured[normalizeZoneName(record.Data)]
+		if !ok {
+			hostname = types.StringValue(record.Data)
+		}
+
+		nameserver := DelegationNameserverData{Hostname: hostname}
+		if inBailiwick(record.Data, fqdn) {
+			if name, ok := relativeRecordName(record.Data, state.Zone.ValueString()); ok {
+				nameserver.IPv4Addresses = recordsToStrings(records[name][utils.RecordTypeA.String()])
+				nameserver.IPv6Addresses = recordsToStrings(records[name][utils.RecordTypeAAAA.String()])
+			}
+		}
+		state.Nameservers = append(state.Nameservers, nameserver)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsDelegationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsDelegationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from state
+	var state dnsDelegationResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, &state, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsDelegationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsDelegationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, nil, &state, "delete")...)
+}
+
+// ImportState imports the delegation with the import ID in the format "zone/name".
+func (r *dnsDelegationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+// apply patches the NS and glue records of the zone from the records of the state to the records of the plan. A nil
+// state creates the delegation, and a nil plan deletes it.
+func (r *dnsDelegationResource) apply(ctx context.Context, plan *dnsDelegationResourceModel, state *dnsDelegationResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zoneName := ""
+	if plan != nil {
+		zoneName = plan.Zone.ValueString()
+	} else {
+		zoneName = state.Zone.ValueString()
+	}
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, zoneName)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", zoneName)
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, zoneName)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return diags
+	}
+
+	// Delete the records of the state, then set the records of the plan
+	records := make(map[string]map[string][]abionclient.Record)
+	for _, model := range []*dnsDelegationResourceModel{state, plan} {
+		if model == nil {
+			continue
+		}
+		for name, recordTypes := range delegationRecords(*model) {
+			if records[name] == nil {
+				records[name] = make(map[string][]abionclient.Record)
+			}
+			for recordType, data := range recordTypes {
+				if model == state {
+					data = nil
+				}
+				records[name][recordType] = data
+			}
+		}
+	}
+
+	patch := abionclient.ZoneRequest{
+		Data: abionclient.Zone{
+			Type: "zone",
+			ID:   zoneName,
+			Attributes: abionclient.Attributes{
+				Records: records,
+			},
+		},
+	}
+
+	// Only send the actual changes, e.g. not the unchanged NS records when glue is added
+	patchRequest := abionclient.DiffZones(*zone.Data, abionclient.ApplyZonePatch(*zone.Data, patch))
+	if abionclient.IsEmptyPatch(patchRequest) {
+		tflog.Debug(ctx, "Delegation is up to date")
+		return diags
+	}
+
+	tflog.Debug(ctx, "Patching delegation records")
+
+	_, err = r.client.PatchZone(ctx, zoneName, patchRequest)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" delegation, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the records to become visible in the zone
+	if plan != nil {
+		diags.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, zoneName, patchRequest)...)
+	}
+
+	return diags
+}
+
+// delegationRecords returns the NS and glue records of the delegation by name and record type.
+func delegationRecords(model dnsDelegationResourceModel) map[string]map[string][]abionclient.Record {
+	records := make(map[string]map[string][]abionclient.Record)
+
+	newRecord := func(data string) abionclient.Record {
+		return abionclient.Record{
+			Data:     data,
+			TTL:      utils.Int32ToIntPointer(model.TTL),
+			Comments: model.Comments.ValueStringPointer(),
+		}
+	}
+
+	add := func(name string, recordType utils.RecordType, data string) {
+		if records[name] == nil {
+			records[name] = make(map[string][]abionclient.Record)
+		}
+		records[name][recordType.String()] = append(records[name][recordType.String()], newRecord(data))
+	}
+
+	for _, nameserver := range model.Nameservers {
+		add(model.Name.ValueString(), utils.RecordTypeNS, normalizeZoneName(nameserver.Hostname.ValueString())+".")
+
+		name, ok := relativeRecordName(nameserver.Hostname.ValueString(), model.Zone.ValueString())
+		if !ok {
+			continue
+		}
+		for _, address := range nameserver.IPv4Addresses {
+			add(name, utils.RecordTypeA, address.ValueString())
+		}
+		for _, address := range nameserver.IPv6Addresses {
+			add(name, utils.RecordTypeAAAA, address.ValueString())
+		}
+	}
+
+	return records
+}
+
+// validateDelegationGlue verifies that every name server within the delegated subdomain has glue, that no name server
+// outside of it has glue, and that no name server is given twice.
+func validateDelegationGlue(model dnsDelegationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	fqdn := delegationFqdn(model.Zone.ValueString(), model.Name.ValueString())
+	seen := make(map[string]bool)
+
+	for i, nameserver := range model.Nameservers {
+		hostname := normalizeZoneName(nameserver.Hostname.ValueString())
+		attributePath := path.Root("nameservers").AtListIndex(i)
+		hasGlue := len(nameserver.IPv4Addresses) > 0 || len(nameserver.IPv6Addresses) > 0
+
+		switch {
+		case seen[hostname]:
+			diags.AddAttributeError(
+				attributePath.AtName("hostname"),
+				"Duplicate name server",
+				"The name server "+hostname+" is given more than once.",
+			)
+		case inBailiwick(hostname, fqdn) && !hasGlue:
+			diags.AddAttributeError(
+				attributePath,
+				"Missing glue",
+				"The name server "+hostname+" is within the delegated subdomain "+fqdn+" and can't be resolved "+
+					"without glue. Set ipv4_addresses or ipv6_addresses.",
+			)
+		case !inBailiwick(hostname, fqdn) && hasGlue:
+			diags.AddAttributeError(
+				attributePath,
+				"Unexpected glue",
+				"The name server "+hostname+" is not within the delegated subdomain "+fqdn+", glue is only "+
+					"allowed for name servers within the subdomain. Remove ipv4_addresses and ipv6_addresses.",
+			)
+		}
+		seen[hostname] = true
+	}
+
+	return diags
+}
+
+// validateDelegationConflicts verifies that the planned delegation doesn't conflict with records of the zone that
+// are not managed by the resource, i.e. that the subdomain has no other records than NS and DS records, and that the
+// glue names have no other address records. Records below the subdomain that aren't glue are occluded by the
+// delegation, which is reported as a warning.
+func validateDelegationConflicts(zone abionclient.Zone, plan dnsDelegationResourceModel, state *dnsDelegationResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned := delegationRecords(plan)
+	owned := make(map[string]map[string][]abionclient.Record)
+	if state != nil {
+		owned = delegationRecords(*state)
+	}
+
+	fqdn := delegationFqdn(plan.Zone.ValueString(), plan.Name.ValueString())
+
+	names := make([]string, 0, len(zone.Attributes.Records))
+	for name := range zone.Attributes.Records {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var occluded []string
+	for _, name := range names {
+		for recordType, records := range zone.Attributes.Records[name] {
+			if len(records) == 0 || owned[name][recordType] != nil {
+				continue
+			}
+
+			_, isPlanned := planned[name][recordType]
+
+			switch {
+			case strings.EqualFold(name, plan.Name.ValueString()) && recordType == utils.RecordTypeNS.String():
+				diags.AddAttributeError(
+					path.Root("name"),
+					"Subdomain already delegated",
+					"The subdomain "+fqdn+" already has NS records. Import the delegation, or remove the NS records.",
+				)
+			case strings.EqualFold(name, plan.Name.ValueString()) && recordType != "DS" && !isPlanned:
+				diags.AddAttributeError(
+					path.Root("name"),
+					"Conflicting records",
+					"The subdomain "+fqdn+" has "+recordType+" records, a delegated name can only have NS and DS records.",
+				)
+			case isPlanned:
+				diags.AddAttributeError(
+					path.Root("nameservers"),
+					"Conflicting glue records",
+					"The glue name "+name+" already has "+recordType+" records that are not managed by the delegation.",
+				)
+			case recordType == utils.RecordTypeCName.String() && planned[name] != nil:
+				diags.AddAttributeError(
+					path.Root("nameservers"),
+					"Conflicting glue records",
+					"The glue name "+name+" has a CNAME record.",
+				)
+			case planned[name] == nil && inBailiwick(name+"."+normalizeZoneName(plan.Zone.ValueString()), fqdn) && !strings.EqualFold(name, plan.Name.ValueString()):
+				occluded = append(occluded, name+"/"+recordType)
+			}
+		}
+	}
+
+	if len(occluded) > 0 {
+		diags.AddAttributeWarning(
+			path.Root("name"),
+			"Occluded records",
+			"The records "+strings.Join(occluded, ", ")+" are below the delegated subdomain "+fqdn+" and are not "+
+				"visible once it is delegated.",
+		)
+	}
+
+	return diags
+}
+
+// delegationFqdn returns the fully qualified name of the delegated subdomain, without a trailing dot.
+func delegationFqdn(zone string, name string) string {
+	return normalizeZoneName(name) + "." + normalizeZoneName(zone)
+}
+
+// inBailiwick returns true if the host name is the domain or within it.
+func inBailiwick(hostname string, domain string) bool {
+	hostname = normalizeZoneName(hostname)
+	domain = normalizeZoneName(domain)
+	return hostname == domain || strings.HasSuffix(hostname, "."+domain)
+}
+
+// recordsToStrings returns the data of the records, or nil if there are none.
+func recordsToStrings(records []abionclient.Record) []types.String {
+	var values []types.String
+	for _, record := range records {
+		values = append(values, types.StringValue(record.Data))
+	}
+	return values
+}
@@ -0,0 +1,173 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+func TestValidateDelegationConflicts(t *testing.T) {
+	model := func(hostname string, ipv4 string) dnsDelegationResourceModel {
+		return dnsDelegationResourceModel{
+			Zone: types.StringValue("example.com"),
+			Name: types.StringValue("team"),
+			Nameservers: []DelegationNameserverData{
+				{Hostname: types.StringValue(hostname), IPv4Addresses: []types.String{types.StringValue(ipv4)}},
+			},
+		}
+	}
+
+	zone := func(records map[string]map[string][]abionclient.Record) abionclient.Zone {
+		return abionclient.Zone{Attributes: abionclient.Attributes{Records: records}}
+	}
+
+	owned := model("ns1.team.example.com.", "192.0.2.1")
+
+	tests := []struct {
+		name     string
+		zone     abionclient.Zone
+		state    *dnsDelegationResourceModel
+		errors   []string
+		warnings []string
+	}{
+		{
+			name: "no conflicts",
+			zone: zone(map[string]map[string][]abionclient.Record{"www": {"A": {{Data: "192.0.2.9"}}}}),
+		},
+		{
+			name:   "already delegated",
+			zone:   zone(map[string]map[string][]abionclient.Record{"team": {"NS": {{Data: "ns.example.net."}}}}),
+			errors: []string{"Subdomain already delegated"},
+		},
+		{
+			name:  "own records",
+			zone:  zone(map[string]map[string][]abionclient.Record{"team": {"NS": {{Data: "ns1.team.example.com."}}, "DS": {{Data: "1 2 3 ab"}}}, "ns1.team": {"A": {{Data: "192.0.2.1"}}}}),
+			state: &owned,
+		},
+		{
+			name:   "records at the subdomain",
+			zone:   zone(map[string]map[string][]abionclient.Record{"team": {"TXT": {{Data: "x"}}}}),
+			errors: []string{"Conflicting records"},
+		},
+		{
+			name:   "glue records not owned",
+			zone:   zone(map[string]map[string][]abionclient.Record{"ns1.team": {"A": {{Data: "192.0.2.8"}}}}),
+			errors: []string{"Conflicting glue records"},
+		},
+		{
+			name:     "occluded records",
+			zone:     zone(map[string]map[string][]abionclient.Record{"www.team": {"A": {{Data: "192.0.2.9"}}}}),
+			warnings: []string{"Occluded records"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			diags := validateDelegationConflicts(test.zone, model("ns1.team.example.com.", "192.0.2.1"), test.state)
+
+			var errors, warnings []string
+			for _, d := range diags.Errors() {
+				errors = append(errors, d.Summary())
+			}
+			for _, d := range diags.Warnings() {
+				warnings = append(warnings, d.Summary())
+			}
+
+			if len(errors) != len(test.errors) || (len(errors) > 0 && errors[0] != test.errors[0]) {
+				t.Errorf("expected errors %v, got %v", test.errors, errors)
+			}
+			if len(warnings) != len(test.warnings) || (len(warnings) > 0 && warnings[0] != test.warnings[0]) {
+				t.Errorf("expected warnings %v, got %v", test.warnings, warnings)
+			}
+		})
+	}
+}
+
+func TestAccDnsDelegationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify that missing glue fails the plan
+			{
+				Config: providerConfig + `
+			resource "abion_dns_delegation" "test" {
+			  zone = "pmapitest2.com"
+			  name = "team"
+			  nameservers = [
+				{
+				  hostname = "ns1.team.pmapitest2.com."
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile("Missing glue"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_delegation" "test" {
+			  zone = "pmapitest2.com"
+			  name = "team"
+			  nameservers = [
+				{
+				  hostname       = "ns1.team.pmapitest2.com."
+				  ipv4_addresses = ["192.0.2.1"]
+				},
+				{
+				  hostname = "ns1.example.net."
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "nameservers.#", "2"),
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "nameservers.0.hostname", "ns1.team.pmapitest2.com."),
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "nameservers.0.ipv4_addresses.0", "192.0.2.1"),
+					resource.TestCheckNoResourceAttr("abion_dns_delegation.test", "nameservers.1.ipv4_addresses"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_delegation" "test" {
+			  zone = "pmapitest2.com"
+			  name = "team"
+			  nameservers = [
+				{
+				  hostname       = "ns2.team.pmapitest2.com."
+				  ipv4_addresses = ["192.0.2.2"]
+				  ipv6_addresses = ["2001:db8::2"]
+				},
+				{
+				  hostname = "ns1.example.net."
+				},
+			  ]
+			  ttl = 7200
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "nameservers.0.hostname", "ns2.team.pmapitest2.com."),
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "nameservers.0.ipv6_addresses.0", "2001:db8::2"),
+					resource.TestCheckResourceAttr("abion_dns_delegation.test", "ttl", "7200"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_delegation.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest2.com/team",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,701 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsDkimKeyResource{}
+	_ resource.ResourceWithConfigure      = &dnsDkimKeyResource{}
+	_ resource.ResourceWithImportState    = &dnsDkimKeyResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsDkimKeyResource{}
+	_ resource.ResourceWithValidateConfig = &dnsDkimKeyResource{}
+)
+
+// dkimLabel is the label of the name the DKIM keys of a domain are published below.
+const dkimLabel = "_domainkey"
+
+// dkimKeyTypes are the values of the k tag of a DKIM record.
+var dkimKeyTypes = []string{"rsa", "ed25519"}
+
+// dkimHashAlgorithms are the values of the h tag of a DKIM record.
+var dkimHashAlgorithms = []string{"sha1", "sha256"}
+
+// dkimFlags are the values of the t tag of a DKIM record.
+var dkimFlags = []string{"y", "s"}
+
+// dkimServiceTypes are the values of the s tag of a DKIM record.
+var dkimServiceTypes = []string{"*", "email"}
+
+// dkimSelectorRegexp matches a DKIM selector, one or more labels separated by dots.
+var dkimSelectorRegexp = regexp.MustCompile(`^[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?)*$`)
+
+// NewDnsDkimKeyResource is a helper function to simplify the provider implementation.
+func NewDnsDkimKeyResource() resource.Resource {
+	return &dnsDkimKeyResource{}
+}
+
+// dnsDkimKeyResource is the resource implementation.
+type dnsDkimKeyResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsDkimKeyResourceModel maps the resource schema data.
+type dnsDkimKeyResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	Selector           types.String   `tfsdk:"selector"`
+	PublicKey          types.String   `tfsdk:"public_key"`
+	K                  types.String   `tfsdk:"k"`
+	H                  []types.String `tfsdk:"h"`
+	T                  []types.String `tfsdk:"t"`
+	S                  []types.String `tfsdk:"s"`
+	P                  types.String   `tfsdk:"p"`
+	Value              types.String   `tfsdk:"value"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// dkimKey is the key of a DKIM record. Tags that are not set are empty.
+type dkimKey struct {
+	K string
+	H []string
+	T []string
+	S []string
+	P string
+}
+
+func (r *dnsDkimKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsDkimKeyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_dkim_key"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsDkimKeyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	flags := func(description string, values []string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: description,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(stringvalidator.OneOf(values...)),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to publish a DKIM public key. The key is published as a TXT record at " +
+			"`<selector>._domainkey.<name>`, split into character strings of at most 255 characters, and replaces " +
+			"any other DKIM record of the selector.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the key belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				MarkdownDescription: "The name of the signing domain, relative to the zone. Defaults to `@`, the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"selector": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The selector of the key, e.g. `mail2024`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(dkimSelectorRegexp, "must be one or more DNS labels separated by dots"),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The public key, either PEM encoded or as the base64 encoded value of the `p` tag. " +
+					"A PEM encoded Ed25519 key is published as the raw key required by RFC 8463.",
+			},
+			"k": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The key type, `rsa` or `ed25519`. Receivers apply `rsa` if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dkimKeyTypes...),
+				},
+			},
+			"h": flags("The acceptable hash algorithms, any of `sha1` and `sha256`. Receivers accept all if not set.", dkimHashAlgorithms),
+			"t": flags("The flags of the key, any of `y` for testing mode and `s` for no subdomains in the signing identity.", dkimFlags),
+			"s": flags("The service types the key applies to, any of `*` and `email`. Receivers apply `*` if not set.", dkimServiceTypes),
+			"p": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The base64 encoded value of the `p` tag of the published key.",
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The text of the DKIM record, before it is split into character strings.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the record to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that the public key can be decoded and matches the key type.
+func (r *dnsDkimKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var publicKey, keyType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("public_key"), &publicKey)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("k"), &keyType)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are verified when the resource is planned
+	if publicKey.IsNull() || publicKey.IsUnknown() || keyType.IsUnknown() {
+		return
+	}
+
+	_, actualType, err := decodeDKIMPublicKey(publicKey.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("public_key"),
+			"Invalid DKIM Public Key",
+			"The public key "+err.Error()+".",
+		)
+		return
+	}
+
+	expectedType := keyType.ValueString()
+	if expectedType == "" {
+		expectedType = "rsa"
+	}
+
+	if actualType != "" && actualType != expectedType {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("k"),
+			"Key type mismatch",
+			"The public key is an "+actualType+" key, but the key type is "+expectedType+". Set k to "+actualType+".",
+		)
+	}
+}
+
+// ModifyPlan plans the record defaults and the text of the record, and validates the planned changes against the
+// allowed_zones and read_only settings of the provider.
+func (r *dnsDkimKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		// The text is unknown until all tags are known
+		if req.Config.Raw.IsFullyKnown() {
+			var plan dnsDkimKeyResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			key, err := plan.key()
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("public_key"),
+					"Invalid DKIM Public Key",
+					"The public key "+err.Error()+".",
+				)
+				return
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("p"), key.P)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), key.String())...)
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsDkimKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsDkimKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsDkimKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsDkimKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	name := dkimRecordName(state.Name.ValueString(), state.Selector.ValueString())
+	records := findTXTRecords(zone.Data.Attributes.Records[name][utils.RecordTypeTXT.String()], isDKIMRecord)
+
+	// The record has been removed outside of Terraform
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(records) > 1 {
+		resp.Diagnostics.AddWarning(
+			"Multiple DKIM records",
+			"The name "+name+" has "+strconv.Itoa(len(records))+" DKIM records, and receivers may fail to verify "+
+				"signatures. The value lists all of them, and the other records are removed when the key is applied.",
+		)
+	}
+
+	record := records[0]
+	text := zonefile.UnquoteTXT(record.Data)
+
+	// The tags of an invalid record are kept as is, and the text shows the drift
+	key, err := parseDKIMKey(text)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Invalid DKIM record",
+			"The DKIM record of "+name+" is invalid and is replaced when the key is applied: "+err.Error(),
+		)
+	} else {
+		state.setKey(key)
+	}
+
+	state.Value = types.StringValue(txtRecordsText(records))
+	state.TTL = utils.IntPointerToInt32(record.TTL)
+	state.Comments = utils.StringPointerToTerraformString(record.Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsDkimKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsDkimKeyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsDkimKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsDkimKeyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := dkimRecordName(state.Name.ValueString(), state.Selector.ValueString())
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", name)
+	tflog.Debug(ctx, "Deleting DKIM record")
+
+	_, err := replaceTXTRecords(ctx, r.client, state.Zone.ValueString(), name, isDKIMRecord, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete DKIM record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the DKIM key of the selector given by the import ID.
+func (r *dnsDkimKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect the import ID to be in the format: "zone/name/selector"
+	// e.g., "example.com/@/mail2024"
+	parts := strings.Split(req.ID, "/")
+
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format `zone/name/selector`, e.g., `example.com/@/mail2024`.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("selector"), parts[2])...)
+}
+
+// put replaces the DKIM records of the selector with the record of the plan and waits for the change to become
+// visible.
+func (r *dnsDkimKeyResource) put(ctx context.Context, plan dnsDkimKeyResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := dkimRecordName(plan.Name.ValueString(), plan.Selector.ValueString())
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", name)
+	tflog.Debug(ctx, "Setting DKIM record")
+
+	record := abionclient.Record{
+		Data:     txtRecordData(plan.Value.ValueString()),
+		TTL:      utils.Int32ToIntPointer(plan.TTL),
+		Comments: plan.Comments.ValueStringPointer(),
+	}
+
+	_, err := replaceTXTRecords(ctx, r.client, plan.Zone.ValueString(), name, isDKIMRecord, &record)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" DKIM record, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the record to become visible in the zone
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), name, utils.RecordTypeTXT, []string{record.Data}, nil)...)
+
+	return diags
+}
+
+// key returns the DKIM key of the public key and tags of the model.
+func (m dnsDkimKeyResourceModel) key() (dkimKey, error) {
+	p, _, err := decodeDKIMPublicKey(m.PublicKey.ValueString())
+	if err != nil {
+		return dkimKey{}, err
+	}
+
+	return dkimKey{
+		K: m.K.ValueString(),
+		H: utils.TerraformStringsToStrings(m.H),
+		T: utils.TerraformStringsToStrings(m.T),
+		S: utils.TerraformStringsToStrings(m.S),
+		P: p,
+	}, nil
+}
+
+// setKey sets the tags of the model to the DKIM key. The public key is kept as configured, e.g. PEM encoded, unless
+// the published key differs from it, so that a key rotated outside of Terraform shows as drift.
+func (m *dnsDkimKeyResourceModel) setKey(key dkimKey) {
+	if p, _, err := decodeDKIMPublicKey(m.PublicKey.ValueString()); err != nil || p != key.P {
+		m.PublicKey = types.StringValue(key.P)
+	}
+
+	m.K = utils.StringToTerraformString(key.K)
+	m.H = utils.StringsToTerraformStrings(key.H)
+	m.T = utils.StringsToTerraformStrings(key.T)
+	m.S = utils.StringsToTerraformStrings(key.S)
+	m.P = types.StringValue(key.P)
+}
+
+// String returns the canonical text of the DKIM record of the key.
+func (k dkimKey) String() string {
+	tags := []string{"v=DKIM1"}
+
+	add := func(tag string, value string) {
+		if value != "" {
+			tags = append(tags, tag+"="+value)
+		}
+	}
+
+	add("k", k.K)
+	add("h", strings.Join(k.H, ":"))
+	add("t", strings.Join(k.T, ":"))
+	add("s", strings.Join(k.S, ":"))
+	tags = append(tags, "p="+k.P)
+
+	return strings.Join(tags, "; ")
+}
+
+// parseDKIMKey parses the text of a DKIM record. Unknown tags are ignored, as required by RFC 6376.
+func parseDKIMKey(text string) (dkimKey, error) {
+	var key dkimKey
+
+	tags, err := parseTagList(text)
+	if err != nil {
+		return key, err
+	}
+
+	for i, tag := range tags {
+		if tag.Name == "v" && (i > 0 || tag.Value != "DKIM1") {
+			return key, errors.New("the v tag must be first and DKIM1")
+		}
+	}
+
+	keywords := func(name string, value string, keywords []string) ([]string, error) {
+		values := splitTagValue(strings.ToLower(value), ":")
+		for _, value := range values {
+			if _, err := parseTagKeyword(name, value, keywords); err != nil {
+				return nil, err
+			}
+		}
+		return values, nil
+	}
+
+	found := false
+	for _, tag := range tags {
+		switch tag.Name {
+		case "k":
+			key.K, err = parseTagKeyword(tag.Name, tag.Value, dkimKeyTypes)
+		case "h":
+			key.H, err = keywords(tag.Name, tag.Value, dkimHashAlgorithms)
+		case "t":
+			key.T, err = keywords(tag.Name, tag.Value, dkimFlags)
+		case "s":
+			key.S, err = keywords(tag.Name, tag.Value, dkimServiceTypes)
+		case "p":
+			key.P, found = strings.Join(strings.Fields(tag.Value), ""), true
+		}
+		if err != nil {
+			return key, err
+		}
+	}
+
+	if !found {
+		return key, errors.New("the required tag p is missing")
+	}
+
+	return key, nil
+}
+
+// decodeDKIMPublicKey returns the value of the p tag of the PEM or base64 encoded public key, and the key type if
+// it is known from the key.
+func decodeDKIMPublicKey(publicKey string) (string, string, error) {
+	publicKey = strings.TrimSpace(publicKey)
+
+	if strings.HasPrefix(publicKey, "-----BEGIN") {
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			return "", "", errors.New("is not a valid PEM block")
+		}
+
+		var key any
+		var err error
+		switch block.Type {
+		case "PUBLIC KEY":
+			key, err = x509.ParsePKIXPublicKey(block.Bytes)
+		case "RSA PUBLIC KEY":
+			key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+		default:
+			return "", "", fmt.Errorf("must be a PUBLIC KEY or RSA PUBLIC KEY PEM block, got %s", block.Type)
+		}
+		if err != nil {
+			return "", "", fmt.Errorf("can't be parsed: %w", err)
+		}
+
+		switch key := key.(type) {
+		case *rsa.PublicKey:
+			der, err := x509.MarshalPKIXPublicKey(key)
+			if err != nil {
+				return "", "", fmt.Errorf("can't be encoded: %w", err)
+			}
+			return base64.StdEncoding.EncodeToString(der), "rsa", nil
+		case ed25519.PublicKey:
+			return base64.StdEncoding.EncodeToString(key), "ed25519", nil
+		default:
+			return "", "", fmt.Errorf("must be an RSA or Ed25519 key, got %T", key)
+		}
+	}
+
+	p := strings.Join(strings.Fields(strings.TrimPrefix(publicKey, "p=")), "")
+	if p == "" {
+		return "", "", errors.New("must not be empty")
+	}
+	der, err := base64.StdEncoding.DecodeString(p)
+	if err != nil {
+		return "", "", errors.New("must be PEM encoded or base64 encoded")
+	}
+
+	// The type of a base64 encoded key is known if it is an RSA key or has the size of an Ed25519 key
+	if key, err := x509.ParsePKIXPublicKey(der); err == nil {
+		if _, ok := key.(*rsa.PublicKey); ok {
+			return p, "rsa", nil
+		}
+	}
+	if len(der) == ed25519.PublicKeySize {
+		return p, "ed25519", nil
+	}
+	return p, "", nil
+}
+
+// isDKIMRecord returns true if the text is a DKIM record, i.e. a tag list with a p tag that doesn't start with the
+// version tag of another kind of record.
+func isDKIMRecord(text string) bool {
+	tags, err := parseTagList(text)
+	if err != nil || (len(tags) > 0 && tags[0].Name == "v" && tags[0].Value != "DKIM1") {
+		return false
+	}
+	for _, tag := range tags {
+		if tag.Name == "p" {
+			return true
+		}
+	}
+	return false
+}
+
+// dkimRecordName returns the name, relative to the zone, of the DKIM record of the selector of the domain name.
+func dkimRecordName(name string, selector string) string {
+	if name == "@" {
+		return selector + "." + dkimLabel
+	}
+	return selector + "." + dkimLabel + "." + name
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+const testDKIMPublicKey = `-----BEGIN PUBLIC KEY-----
+MIIBIjANBgkqhkiG9w0BAQEFAAOCAQ8AMIIBCgKCAQEAozgHYVAg10krgbkoTpZT
+L3C16GD2dh/wCgLBpUWj+RTICAVY+yHBVYSxp4HYMEQu/7NM8YVj8LL9O71FFSo4
+D3b37aLnu2W+KP8UCKpr1iNacQv9zBlI4eArA0Xs5BrmIeprJ2TOaElqzC1ckiki
+ZbQVFZlTQUtP6q840uzO6Wxtq5gM2RNrgWSMCLlkHv5mpIR91AOcYCrAYPcsZBxz
+Wc736QcFvYHjAz/4dkAUVsZyZlGPH1NSlRVdFONT3CDl2nPjlQaJQqhv4fSK2rLv
+oGp6BkAxdtUBEgP2drxkZsLp5DdyYZ0jedtEfV8FodkTAwH3iBBatchxNS7RjAss
+pwIDAQAB
+-----END PUBLIC KEY-----
+`
+
+const testDKIMRotatedKey = "MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQCqXl+0swcN3+GBiz19TpV7FhtLisi2LSrXpcG9LZaFBLoIRUr+i+vgURvubw9PyXseFTI1LBXgxTagjfSw9iqc41cV7dTtgVN5HjRXHWiA/Q+H5iZw9PBoQMhETFNdB7gY5dyPqMz7f/qO4FW3yvJZpk5RCEe2Mf1pcxjSJa7L5wIDAQAB"
+
+func TestDecodeDKIMPublicKey(t *testing.T) {
+	block, _ := pem.Decode([]byte(testDKIMPublicKey))
+	expected := base64.StdEncoding.EncodeToString(block.Bytes)
+
+	p, keyType, err := decodeDKIMPublicKey(testDKIMPublicKey)
+	if err != nil || p != expected || keyType != "rsa" {
+		t.Errorf("unexpected PEM key %s, %s, %v", p, keyType, err)
+	}
+
+	p, keyType, err = decodeDKIMPublicKey("p=" + expected[:40] + "\n  " + expected[40:])
+	if err != nil || p != expected || keyType != "rsa" {
+		t.Errorf("unexpected base64 key %s, %s, %v", p, keyType, err)
+	}
+
+	// Ed25519 keys are published as the raw key
+	public, _, _ := ed25519.GenerateKey(nil)
+	der, _ := x509.MarshalPKIXPublicKey(public)
+	p, keyType, err = decodeDKIMPublicKey(string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	if err != nil || p != base64.StdEncoding.EncodeToString(public) || keyType != "ed25519" {
+		t.Errorf("unexpected Ed25519 key %s, %s, %v", p, keyType, err)
+	}
+
+	if _, keyType, _ := decodeDKIMPublicKey(base64.StdEncoding.EncodeToString(public)); keyType != "ed25519" {
+		t.Errorf("expected a base64 encoded Ed25519 key, got %s", keyType)
+	}
+
+	for _, invalid := range []string{"", "not base64!", "-----BEGIN PUBLIC KEY-----\nnope\n-----END PUBLIC KEY-----", string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))} {
+		if _, _, err := decodeDKIMPublicKey(invalid); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestDKIMKey(t *testing.T) {
+	key := dkimKey{
+		K: "rsa",
+		H: []string{"sha256"},
+		T: []string{"y", "s"},
+		S: []string{"email"},
+		P: testDKIMRotatedKey,
+	}
+
+	text := key.String()
+	if text != "v=DKIM1; k=rsa; h=sha256; t=y:s; s=email; p="+testDKIMRotatedKey {
+		t.Fatalf("unexpected text %s", text)
+	}
+
+	parsed, err := parseDKIMKey(text)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, key) {
+		t.Errorf("expected %+v, got %+v", key, parsed)
+	}
+
+	// A 2048 bit key is split into character strings
+	p, _, _ := decodeDKIMPublicKey(testDKIMPublicKey)
+	data := txtRecordData(dkimKey{P: p}.String())
+	if !strings.HasPrefix(data, `"v=DKIM1; p=`) || strings.Count(data, `"`) != 4 || zonefile.UnquoteTXT(data) != "v=DKIM1; p="+p {
+		t.Errorf("unexpected record data %s", data)
+	}
+
+	if !isDKIMRecord("k=rsa; p=") || isDKIMRecord("v=DMARC1; p=none") || isDKIMRecord("v=spf1 -all") {
+		t.Errorf("unexpected DKIM record detection")
+	}
+
+	for _, invalid := range []string{"v=DKIM1; k=rsa", "k=rsa; v=DKIM1; p=abc", "v=DKIM1; k=dsa; p=abc", "v=DKIM1; h=md5; p=abc"} {
+		if _, err := parseDKIMKey(invalid); err == nil {
+			t.Errorf("expected an error for %s", invalid)
+		}
+	}
+}
+
+func TestAccDnsDkimKeyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_dkim_key" "test" {
+			  zone       = "pmapitest1.com"
+			  selector   = "test2024"
+			  public_key = <<-EOT
+` + testDKIMPublicKey + `EOT
+			  h          = ["sha256"]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_dkim_key.test", "name", "@"),
+					resource.TestCheckResourceAttr("abion_dns_dkim_key.test", "h.#", "1"),
+					resource.TestCheckResourceAttrSet("abion_dns_dkim_key.test", "p"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_dkim_key.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/@/test2024",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "selector",
+				ImportStateVerifyIgnore:              []string{"public_key"},
+			},
+			// Update and Read testing, rotate the key
+			{
+				Config: providerConfig + `
+			resource "abion_dns_dkim_key" "test" {
+			  zone       = "pmapitest1.com"
+			  selector   = "test2024"
+			  public_key = "` + testDKIMRotatedKey + `"
+			  t          = ["y"]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_dkim_key.test", "value", "v=DKIM1; t=y; p="+testDKIMRotatedKey),
+					resource.TestCheckNoResourceAttr("abion_dns_dkim_key.test", "h"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,605 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsDmarcPolicyResource{}
+	_ resource.ResourceWithConfigure   = &dnsDmarcPolicyResource{}
+	_ resource.ResourceWithImportState = &dnsDmarcPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsDmarcPolicyResource{}
+)
+
+// dmarcLabel is the label of the name the DMARC policy of a domain is published at.
+const dmarcLabel = "_dmarc"
+
+// dmarcPolicies are the values of the p and sp tags of a DMARC record.
+var dmarcPolicies = []string{"none", "quarantine", "reject"}
+
+// dmarcAlignments are the values of the adkim and aspf tags of a DMARC record.
+var dmarcAlignments = []string{"r", "s"}
+
+// dmarcFailureOptions are the values of the fo tag of a DMARC record.
+var dmarcFailureOptions = []string{"0", "1", "d", "s"}
+
+// dmarcURIRegexp matches a DMARC report URI, a mailto URI with an optional size limit, e.g. mailto:dmarc@example.com!10m.
+var dmarcURIRegexp = regexp.MustCompile(`^mailto:[^\s,;!@]+@([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}(![0-9]+[kmgt]?)?$`)
+
+// dmarcVersionRegexp matches the version tag a DMARC record starts with.
+var dmarcVersionRegexp = regexp.MustCompile(`^\s*v\s*=\s*DMARC1\s*(;|$)`)
+
+// NewDnsDmarcPolicyResource is a helper function to simplify the provider implementation.
+func NewDnsDmarcPolicyResource() resource.Resource {
+	return &dnsDmarcPolicyResource{}
+}
+
+// dnsDmarcPolicyResource is the resource implementation.
+type dnsDmarcPolicyResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsDmarcPolicyResourceModel maps the resource schema data.
+type dnsDmarcPolicyResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	P                  types.String   `tfsdk:"p"`
+	SP                 types.String   `tfsdk:"sp"`
+	Pct                types.Int32    `tfsdk:"pct"`
+	Rua                []types.String `tfsdk:"rua"`
+	Ruf                []types.String `tfsdk:"ruf"`
+	Adkim              types.String   `tfsdk:"adkim"`
+	Aspf               types.String   `tfsdk:"aspf"`
+	Fo                 []types.String `tfsdk:"fo"`
+	Ri                 types.Int32    `tfsdk:"ri"`
+	Value              types.String   `tfsdk:"value"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// dmarcPolicy is the policy of a DMARC record. Tags that are not set are empty.
+type dmarcPolicy struct {
+	P     string
+	SP    string
+	Pct   *int
+	Rua   []string
+	Ruf   []string
+	Adkim string
+	Aspf  string
+	Fo    []string
+	Ri    *int
+}
+
+func (r *dnsDmarcPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsDmarcPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_dmarc_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsDmarcPolicyResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	reportURIs := func(description string) schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: description,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(
+					stringvalidator.RegexMatches(dmarcURIRegexp, "must be a mailto URI, e.g. mailto:dmarc@example.com"),
+				),
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the DMARC policy of a domain. The policy is published as a TXT " +
+			"record at `_dmarc.<name>`, and replaces any other DMARC record of the name. Other TXT records of the name " +
+			"are left untouched.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the policy belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				MarkdownDescription: "The name of the domain the policy applies to, relative to the zone. Defaults to `@`, the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"p": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The policy for the domain, one of `none`, `quarantine` or `reject`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dmarcPolicies...),
+				},
+			},
+			"sp": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The policy for the subdomains of the domain, one of `none`, `quarantine` or `reject`. Receivers apply `p` if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dmarcPolicies...),
+				},
+			},
+			"pct": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The percentage of failing messages the policy is applied to, between 0 and 100. Receivers apply 100 if not set.",
+				Validators: []validator.Int32{
+					int32validator.Between(0, 100),
+				},
+			},
+			"rua": reportURIs("The URIs aggregate reports are sent to, e.g. `mailto:dmarc@example.com`. A size limit " +
+				"can be appended, e.g. `mailto:dmarc@example.com!10m`."),
+			"ruf": reportURIs("The URIs failure reports are sent to, e.g. `mailto:dmarc@example.com`. A size limit " +
+				"can be appended, e.g. `mailto:dmarc@example.com!10m`."),
+			"adkim": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The DKIM alignment mode, `r` for relaxed or `s` for strict. Receivers apply `r` if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dmarcAlignments...),
+				},
+			},
+			"aspf": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The SPF alignment mode, `r` for relaxed or `s` for strict. Receivers apply `r` if not set.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dmarcAlignments...),
+				},
+			},
+			"fo": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The failure reporting options, any of `0`, `1`, `d` and `s`. Receivers apply `0` " +
+					"if not set.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.OneOf(dmarcFailureOptions...)),
+				},
+			},
+			"ri": schema.Int32Attribute{
+				Optional:    true,
+				Description: "The interval between aggregate reports, in seconds. Receivers apply 86400 if not set.",
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The text of the DMARC record.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the record to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans the record defaults and the text of the record, and validates the planned changes against the
+// allowed_zones and read_only settings of the provider.
+func (r *dnsDmarcPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		// The text is unknown until all tags are known
+		if req.Config.Raw.IsFullyKnown() {
+			var plan dnsDmarcPolicyResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), plan.policy().String())...)
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsDmarcPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsDmarcPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsDmarcPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsDmarcPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := findTXTRecords(zone.Data.Attributes.Records[dmarcRecordName(state.Name.ValueString())][utils.RecordTypeTXT.String()], isDMARCRecord)
+
+	// The record has been removed outside of Terraform
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(records) > 1 {
+		resp.Diagnostics.AddWarning(
+			"Multiple DMARC records",
+			"The name "+dmarcRecordName(state.Name.ValueString())+" has "+strconv.Itoa(len(records))+" DMARC records, and "+
+				"receivers ignore all of them. The value lists all of them, and the other records are removed when the "+
+				"policy is applied.",
+		)
+	}
+
+	record := records[0]
+	text := zonefile.UnquoteTXT(record.Data)
+
+	// The tags of an invalid record are kept as is, and the text shows the drift
+	policy, err := parseDMARCPolicy(text)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Invalid DMARC record",
+			"The DMARC record of "+dmarcRecordName(state.Name.ValueString())+" is invalid and is replaced when the policy "+
+				"is applied: "+err.Error(),
+		)
+	} else {
+		state.setPolicy(policy)
+	}
+
+	state.Value = types.StringValue(txtRecordsText(records))
+	state.TTL = utils.IntPointerToInt32(record.TTL)
+	state.Comments = utils.StringPointerToTerraformString(record.Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsDmarcPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsDmarcPolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsDmarcPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsDmarcPolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", dmarcRecordName(state.Name.ValueString()))
+	tflog.Debug(ctx, "Deleting DMARC record")
+
+	_, err := replaceTXTRecords(ctx, r.client, state.Zone.ValueString(), dmarcRecordName(state.Name.ValueString()), isDMARCRecord, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete DMARC record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the DMARC policy of the domain given by the import ID.
+func (r *dnsDmarcPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+// put replaces the DMARC records of the name with the record of the plan and waits for the change to become visible.
+func (r *dnsDmarcPolicyResource) put(ctx context.Context, plan dnsDmarcPolicyResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	name := dmarcRecordName(plan.Name.ValueString())
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", name)
+	tflog.Debug(ctx, "Setting DMARC record")
+
+	record := abionclient.Record{
+		Data:     txtRecordData(plan.Value.ValueString()),
+		TTL:      utils.Int32ToIntPointer(plan.TTL),
+		Comments: plan.Comments.ValueStringPointer(),
+	}
+
+	_, err := replaceTXTRecords(ctx, r.client, plan.Zone.ValueString(), name, isDMARCRecord, &record)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" DMARC record, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the record to become visible in the zone
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), name, utils.RecordTypeTXT, []string{record.Data}, nil)...)
+
+	return diags
+}
+
+// policy returns the DMARC policy of the tags of the model.
+func (m dnsDmarcPolicyResourceModel) policy() dmarcPolicy {
+	return dmarcPolicy{
+		P:     m.P.ValueString(),
+		SP:    m.SP.ValueString(),
+		Pct:   utils.Int32ToIntPointer(m.Pct),
+		Rua:   utils.TerraformStringsToStrings(m.Rua),
+		Ruf:   utils.TerraformStringsToStrings(m.Ruf),
+		Adkim: m.Adkim.ValueString(),
+		Aspf:  m.Aspf.ValueString(),
+		Fo:    utils.TerraformStringsToStrings(m.Fo),
+		Ri:    utils.Int32ToIntPointer(m.Ri),
+	}
+}
+
+// setPolicy sets the tags of the model to the DMARC policy.
+func (m *dnsDmarcPolicyResourceModel) setPolicy(policy dmarcPolicy) {
+	m.P = types.StringValue(policy.P)
+	m.SP = utils.StringToTerraformString(policy.SP)
+	m.Pct = utils.IntPointerToInt32(policy.Pct)
+	m.Rua = utils.StringsToTerraformStrings(policy.Rua)
+	m.Ruf = utils.StringsToTerraformStrings(policy.Ruf)
+	m.Adkim = utils.StringToTerraformString(policy.Adkim)
+	m.Aspf = utils.StringToTerraformString(policy.Aspf)
+	m.Fo = utils.StringsToTerraformStrings(policy.Fo)
+	m.Ri = utils.IntPointerToInt32(policy.Ri)
+}
+
+// String returns the canonical text of the DMARC record of the policy.
+func (p dmarcPolicy) String() string {
+	tags := []string{"v=DMARC1", "p=" + p.P}
+
+	add := func(tag string, value string) {
+		if value != "" {
+			tags = append(tags, tag+"="+value)
+		}
+	}
+	addInt := func(tag string, value *int) {
+		if value != nil {
+			tags = append(tags, tag+"="+strconv.Itoa(*value))
+		}
+	}
+
+	add("sp", p.SP)
+	addInt("pct", p.Pct)
+	add("rua", strings.Join(p.Rua, ","))
+	add("ruf", strings.Join(p.Ruf, ","))
+	add("adkim", p.Adkim)
+	add("aspf", p.Aspf)
+	add("fo", strings.Join(p.Fo, ":"))
+	addInt("ri", p.Ri)
+
+	return strings.Join(tags, "; ")
+}
+
+// parseDMARCPolicy parses the text of a DMARC record. Unknown tags are ignored, as required by RFC 7489.
+func parseDMARCPolicy(text string) (dmarcPolicy, error) {
+	var policy dmarcPolicy
+
+	tags, err := parseTagList(text)
+	if err != nil {
+		return policy, err
+	}
+
+	if len(tags) == 0 || tags[0].Name != "v" || tags[0].Value != "DMARC1" {
+		return policy, errors.New("the record doesn't start with v=DMARC1")
+	}
+
+	for _, tag := range tags[1:] {
+		name, value := tag.Name, tag.Value
+
+		switch name {
+		case "p":
+			policy.P, err = parseTagKeyword(name, value, dmarcPolicies)
+		case "sp":
+			policy.SP, err = parseTagKeyword(name, value, dmarcPolicies)
+		case "pct":
+			policy.Pct, err = parseTagInt(name, value, 0, 100)
+		case "rua":
+			policy.Rua = splitTagValue(value, ",")
+		case "ruf":
+			policy.Ruf = splitTagValue(value, ",")
+		case "adkim":
+			policy.Adkim, err = parseTagKeyword(name, value, dmarcAlignments)
+		case "aspf":
+			policy.Aspf, err = parseTagKeyword(name, value, dmarcAlignments)
+		case "fo":
+			policy.Fo = splitTagValue(strings.ToLower(value), ":")
+			for _, option := range policy.Fo {
+				if _, err = parseTagKeyword(name, option, dmarcFailureOptions); err != nil {
+					break
+				}
+			}
+		case "ri":
+			policy.Ri, err = parseTagInt(name, value, 1, 1<<31-1)
+		}
+		if err != nil {
+			return policy, err
+		}
+	}
+
+	if policy.P == "" {
+		return policy, errors.New("the required tag p is missing")
+	}
+
+	return policy, nil
+}
+
+// isDMARCRecord returns true if the text is a DMARC record, i.e. starts with the v=DMARC1 tag.
+func isDMARCRecord(text string) bool {
+	return dmarcVersionRegexp.MatchString(text)
+}
+
+// dmarcRecordName returns the name, relative to the zone, of the DMARC record of the domain name.
+func dmarcRecordName(name string) string {
+	if name == "@" {
+		return dmarcLabel
+	}
+	return dmarcLabel + "." + name
+}
@@ -0,0 +1,130 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestDMARCPolicy(t *testing.T) {
+	pct := 50
+	ri := 3600
+	policy := dmarcPolicy{
+		P:     "reject",
+		SP:    "quarantine",
+		Pct:   &pct,
+		Rua:   []string{"mailto:dmarc@example.com", "mailto:dmarc@example.net!10m"},
+		Ruf:   []string{"mailto:forensic@example.com"},
+		Adkim: "s",
+		Aspf:  "r",
+		Fo:    []string{"0", "d"},
+		Ri:    &ri,
+	}
+
+	text := policy.String()
+	expected := "v=DMARC1; p=reject; sp=quarantine; pct=50; rua=mailto:dmarc@example.com,mailto:dmarc@example.net!10m; " +
+		"ruf=mailto:forensic@example.com; adkim=s; aspf=r; fo=0:d; ri=3600"
+	if text != expected {
+		t.Fatalf("expected %s, got %s", expected, text)
+	}
+
+	parsed, err := parseDMARCPolicy(text)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, policy) {
+		t.Errorf("expected %+v, got %+v", policy, parsed)
+	}
+
+	if text := (dmarcPolicy{P: "none"}).String(); text != "v=DMARC1; p=none" {
+		t.Errorf("unexpected minimal record %s", text)
+	}
+}
+
+func TestParseDMARCPolicy(t *testing.T) {
+	parsed, err := parseDMARCPolicy("v=DMARC1;p=Reject ; RUA = mailto:a@example.com , mailto:b@example.com; x=ignored;")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if parsed.P != "reject" || !reflect.DeepEqual(parsed.Rua, []string{"mailto:a@example.com", "mailto:b@example.com"}) {
+		t.Errorf("unexpected policy %+v", parsed)
+	}
+
+	invalid := []string{
+		"v=spf1 -all",
+		"p=reject; v=DMARC1",
+		"v=DMARC1",
+		"v=DMARC1; p=block",
+		"v=DMARC1; p=none; pct=101",
+		"v=DMARC1; p=none; adkim=x",
+		"v=DMARC1; p=none; fo=0:x",
+		"v=DMARC1; p=none; ri=-1",
+		"v=DMARC1; p=none; ri=0",
+		"v=DMARC1; p",
+	}
+	for _, text := range invalid {
+		if _, err := parseDMARCPolicy(text); err == nil {
+			t.Errorf("expected an error for %s", text)
+		}
+	}
+}
+
+func TestAccDnsDmarcPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_dmarc_policy" "test" {
+			  zone = "pmapitest1.com"
+			  p    = "quarantine"
+			  pct  = 25
+			  rua  = ["mailto:dmarc@pmapitest1.com"]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_dmarc_policy.test", "name", "@"),
+					resource.TestCheckResourceAttr("abion_dns_dmarc_policy.test", "value", "v=DMARC1; p=quarantine; pct=25; rua=mailto:dmarc@pmapitest1.com"),
+					resource.TestCheckNoResourceAttr("abion_dns_dmarc_policy.test", "sp"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_dmarc_policy.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/@",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_dmarc_policy" "test" {
+			  zone  = "pmapitest1.com"
+			  p     = "reject"
+			  sp    = "quarantine"
+			  rua   = ["mailto:dmarc@pmapitest1.com"]
+			  ruf   = ["mailto:forensic@pmapitest1.com!10m"]
+			  adkim = "s"
+			  aspf  = "s"
+			  fo    = ["1"]
+			  ri    = 3600
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_dmarc_policy.test", "value",
+						"v=DMARC1; p=reject; sp=quarantine; rua=mailto:dmarc@pmapitest1.com; ruf=mailto:forensic@pmapitest1.com!10m; adkim=s; aspf=s; fo=1; ri=3600"),
+					resource.TestCheckNoResourceAttr("abion_dns_dmarc_policy.test", "pct"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,619 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsMtaStsResource{}
+	_ resource.ResourceWithConfigure      = &dnsMtaStsResource{}
+	_ resource.ResourceWithImportState    = &dnsMtaStsResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsMtaStsResource{}
+	_ resource.ResourceWithValidateConfig = &dnsMtaStsResource{}
+)
+
+// Labels of the names the MTA-STS and TLS-RPT records of a domain are published at.
+const (
+	mtaStsLabel = "_mta-sts"
+	tlsRptLabel = "_smtp._tls"
+)
+
+// Limits of an MTA-STS policy, from RFC 8461.
+const (
+	maxMTASTSIDLength = 32
+	maxMTASTSMaxAge   = 31557600
+)
+
+// mtaStsModes are the values of the mode field of an MTA-STS policy.
+var mtaStsModes = []string{"enforce", "testing", "none"}
+
+// tlsRptURIRegexp matches a TLS-RPT report URI, a mailto or https URI.
+var tlsRptURIRegexp = regexp.MustCompile(`^(mailto:[^\s,;!@]+@([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,}|https://[^\s,;!/]+(/[^\s,;!]*)?)$`)
+
+// policyHashRegexp matches a hex encoded digest of at least 64 bits, e.g. the output of the sha256 function.
+var policyHashRegexp = regexp.MustCompile(`^[0-9a-fA-F]{16,}$`)
+
+// mtaStsVersionRegexp matches the version tag an MTA-STS record starts with.
+var mtaStsVersionRegexp = regexp.MustCompile(`^\s*v\s*=\s*STSv1\s*(;|$)`)
+
+// tlsRptVersionRegexp matches the version tag a TLS-RPT record starts with.
+var tlsRptVersionRegexp = regexp.MustCompile(`^\s*v\s*=\s*TLSRPTv1\s*(;|$)`)
+
+// NewDnsMtaStsResource is a helper function to simplify the provider implementation.
+func NewDnsMtaStsResource() resource.Resource {
+	return &dnsMtaStsResource{}
+}
+
+// dnsMtaStsResource is the resource implementation.
+type dnsMtaStsResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsMtaStsResourceModel maps the resource schema data.
+type dnsMtaStsResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	Policy             types.String   `tfsdk:"policy"`
+	PolicyHash         types.String   `tfsdk:"policy_hash"`
+	PolicyID           types.String   `tfsdk:"policy_id"`
+	Rua                []types.String `tfsdk:"rua"`
+	MtaStsValue        types.String   `tfsdk:"mta_sts_value"`
+	TLSRptValue        types.String   `tfsdk:"tls_rpt_value"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsMtaStsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsMtaStsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_mta_sts"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsMtaStsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the MTA-STS and TLS-RPT records of a domain. The MTA-STS record " +
+			"is published as a TXT record at `_mta-sts.<name>`, with an id derived from the policy, and the TLS-RPT " +
+			"record as a TXT record at `_smtp._tls.<name>`. Both replace any other record of the same kind of the name. " +
+			"The policy itself must be served at `https://mta-sts.<domain>/.well-known/mta-sts.txt`.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the records belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				MarkdownDescription: "The name of the mail domain, relative to the zone. Defaults to `@`, the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The content of the MTA-STS policy file. The syntax of the policy is validated, and " +
+					"the id is derived from its SHA-256 hash. Exactly one of `policy` and `policy_hash` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("policy_hash")),
+				},
+			},
+			"policy_hash": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "A hex encoded hash of the policy file served elsewhere, e.g. " +
+					"`sha256(file(\"mta-sts.txt\"))`. The id is derived from its first 32 characters.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(policyHashRegexp, "must be a hex encoded hash of at least 16 characters"),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The id of the MTA-STS record, which changes whenever the policy changes.",
+			},
+			"rua": schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "The URIs TLS reports are sent to, `mailto` or `https` URIs, e.g. " +
+					"`mailto:tls-reports@example.com`.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(tlsRptURIRegexp, "must be a mailto or https URI, e.g. mailto:tls-reports@example.com"),
+					),
+				},
+			},
+			"mta_sts_value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The text of the MTA-STS record.",
+			},
+			"tls_rpt_value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The text of the TLS-RPT record.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the records, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the records. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies the syntax of the MTA-STS policy.
+func (r *dnsMtaStsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var policy types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("policy"), &policy)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are verified when the resource is planned
+	if policy.IsNull() || policy.IsUnknown() {
+		return
+	}
+
+	if err := validateMTASTSPolicy(policy.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("policy"),
+			"Invalid MTA-STS Policy",
+			"The MTA-STS policy is invalid: "+err.Error(),
+		)
+	}
+}
+
+// ModifyPlan plans the record defaults, the id and the text of the records, and validates the planned changes
+// against the allowed_zones and read_only settings of the provider.
+func (r *dnsMtaStsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		// The id and the texts are unknown until the policy and the report URIs are known
+		if req.Config.Raw.IsFullyKnown() {
+			var plan dnsMtaStsResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			id := mtaStsID(plan.Policy, plan.PolicyHash)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("policy_id"), id)...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("mta_sts_value"), mtaStsRecordText(id))...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tls_rpt_value"), tlsRptRecordText(utils.TerraformStringsToStrings(plan.Rua)))...)
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsMtaStsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsMtaStsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsMtaStsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsMtaStsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	mtaStsName := mailRecordName(mtaStsLabel, state.Name.ValueString())
+	tlsRptName := mailRecordName(tlsRptLabel, state.Name.ValueString())
+	mtaStsRecords := findTXTRecords(zone.Data.Attributes.Records[mtaStsName][utils.RecordTypeTXT.String()], isMTASTSRecord)
+	tlsRptRecords := findTXTRecords(zone.Data.Attributes.Records[tlsRptName][utils.RecordTypeTXT.String()], isTLSRPTRecord)
+
+	// The records have been removed outside of Terraform
+	if len(mtaStsRecords) == 0 && len(tlsRptRecords) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// A missing record is recreated when the resource is applied
+	state.MtaStsValue = types.StringNull()
+	if len(mtaStsRecords) > 0 {
+		text := zonefile.UnquoteTXT(mtaStsRecords[0].Data)
+		if id, err := parseMTASTSRecord(text); err == nil {
+			state.PolicyID = types.StringValue(id)
+		}
+		state.MtaStsValue = types.StringValue(txtRecordsText(mtaStsRecords))
+		state.TTL = utils.IntPointerToInt32(mtaStsRecords[0].TTL)
+		state.Comments = utils.StringPointerToTerraformString(mtaStsRecords[0].Comments)
+	}
+
+	state.TLSRptValue = types.StringNull()
+	if len(tlsRptRecords) > 0 {
+		text := zonefile.UnquoteTXT(tlsRptRecords[0].Data)
+		if rua, err := parseTLSRPTRecord(text); err == nil {
+			state.Rua = utils.StringsToTerraformStrings(rua)
+		}
+		state.TLSRptValue = types.StringValue(txtRecordsText(tlsRptRecords))
+		if len(mtaStsRecords) == 0 {
+			state.TTL = utils.IntPointerToInt32(tlsRptRecords[0].TTL)
+			state.Comments = utils.StringPointerToTerraformString(tlsRptRecords[0].Comments)
+		}
+	}
+
+	for _, kind := range []struct {
+		name    string
+		label   string
+		records []abionclient.Record
+	}{
+		{mtaStsName, "MTA-STS", mtaStsRecords},
+		{tlsRptName, "TLS-RPT", tlsRptRecords},
+	} {
+		if len(kind.records) > 1 {
+			resp.Diagnostics.AddWarning(
+				"Multiple "+kind.label+" records",
+				"The name "+kind.name+" has "+strconv.Itoa(len(kind.records))+" "+kind.label+" records, and senders "+
+					"ignore all of them. The value lists all of them, and the other records are removed when the "+
+					"resource is applied.",
+			)
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsMtaStsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsMtaStsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsMtaStsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsMtaStsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Deleting MTA-STS and TLS-RPT records")
+
+	_, err := replaceTXTRecords(ctx, r.client, state.Zone.ValueString(), mailRecordName(mtaStsLabel, state.Name.ValueString()), isMTASTSRecord, nil)
+	if err == nil {
+		_, err = replaceTXTRecords(ctx, r.client, state.Zone.ValueString(), mailRecordName(tlsRptLabel, state.Name.ValueString()), isTLSRPTRecord, nil)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete MTA-STS records, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the MTA-STS and TLS-RPT records of the domain given by the import ID.
+func (r *dnsMtaStsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+// put replaces the MTA-STS and TLS-RPT records of the name with the records of the plan and waits for the changes to
+// become visible.
+func (r *dnsMtaStsResource) put(ctx context.Context, plan dnsMtaStsResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	tflog.Debug(ctx, "Setting MTA-STS and TLS-RPT records")
+
+	records := []struct {
+		name  string
+		text  string
+		match func(text string) bool
+	}{
+		{mailRecordName(mtaStsLabel, plan.Name.ValueString()), plan.MtaStsValue.ValueString(), isMTASTSRecord},
+		{mailRecordName(tlsRptLabel, plan.Name.ValueString()), plan.TLSRptValue.ValueString(), isTLSRPTRecord},
+	}
+
+	for _, txt := range records {
+		record := abionclient.Record{
+			Data:     txtRecordData(txt.text),
+			TTL:      utils.Int32ToIntPointer(plan.TTL),
+			Comments: plan.Comments.ValueStringPointer(),
+		}
+
+		_, err := replaceTXTRecords(ctx, r.client, plan.Zone.ValueString(), txt.name, txt.match, &record)
+		if err != nil {
+			diags.AddError(
+				"Error patching zone",
+				"Could not "+operation+" "+txt.name+" record, unexpected error: "+err.Error(),
+			)
+			return diags
+		}
+	}
+
+	// Wait for the records to become visible in the zone
+	for _, txt := range records {
+		diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), txt.name, utils.RecordTypeTXT, []string{txtRecordData(txt.text)}, nil)...)
+	}
+
+	return diags
+}
+
+// mtaStsID returns the id of the MTA-STS record, the first 32 characters of the hex encoded SHA-256 hash of the
+// policy, or of the given hash of the policy.
+func mtaStsID(policy types.String, policyHash types.String) string {
+	hash := strings.ToLower(policyHash.ValueString())
+	if !policy.IsNull() {
+		sum := sha256.Sum256([]byte(policy.ValueString()))
+		hash = hex.EncodeToString(sum[:])
+	}
+
+	if len(hash) > maxMTASTSIDLength {
+		hash = hash[:maxMTASTSIDLength]
+	}
+	return hash
+}
+
+// mtaStsRecordText returns the text of the MTA-STS record with the id.
+func mtaStsRecordText(id string) string {
+	return "v=STSv1; id=" + id
+}
+
+// tlsRptRecordText returns the text of the TLS-RPT record with the report URIs.
+func tlsRptRecordText(rua []string) string {
+	return "v=TLSRPTv1; rua=" + strings.Join(rua, ",")
+}
+
+// parseMTASTSRecord returns the id of the text of an MTA-STS record.
+func parseMTASTSRecord(text string) (string, error) {
+	tags, err := parseTagList(text)
+	if err != nil {
+		return "", err
+	}
+	for _, tag := range tags {
+		if tag.Name == "id" {
+			return tag.Value, nil
+		}
+	}
+	return "", errors.New("the required tag id is missing")
+}
+
+// parseTLSRPTRecord returns the report URIs of the text of a TLS-RPT record.
+func parseTLSRPTRecord(text string) ([]string, error) {
+	tags, err := parseTagList(text)
+	if err != nil {
+		return nil, err
+	}
+	for _, tag := range tags {
+		if tag.Name == "rua" {
+			return splitTagValue(tag.Value, ","), nil
+		}
+	}
+	return nil, errors.New("the required tag rua is missing")
+}
+
+// validateMTASTSPolicy verifies the syntax of the MTA-STS policy, as defined by RFC 8461. Unknown fields are ignored.
+func validateMTASTSPolicy(policy string) error {
+	fields := make(map[string][]string)
+
+	for i, line := range strings.Split(strings.ReplaceAll(policy, "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if !found {
+			return fmt.Errorf("line %d: expected a field in the format key: value", i+1)
+		}
+		key = strings.TrimSpace(key)
+		fields[key] = append(fields[key], strings.TrimSpace(value))
+	}
+
+	for _, key := range []string{"version", "mode", "max_age"} {
+		if len(fields[key]) != 1 {
+			return fmt.Errorf("the field %s must be set once", key)
+		}
+	}
+
+	if fields["version"][0] != "STSv1" {
+		return fmt.Errorf("the version must be STSv1, got %s", fields["version"][0])
+	}
+
+	mode := fields["mode"][0]
+	if _, err := parseTagKeyword("mode", mode, mtaStsModes); err != nil || mode != strings.ToLower(mode) {
+		return fmt.Errorf("the mode must be one of %s, got %s", strings.Join(mtaStsModes, ", "), mode)
+	}
+
+	if maxAge, err := strconv.Atoi(fields["max_age"][0]); err != nil || maxAge < 0 || maxAge > maxMTASTSMaxAge {
+		return fmt.Errorf("the max_age must be an integer between 0 and %d, got %s", maxMTASTSMaxAge, fields["max_age"][0])
+	}
+
+	if len(fields["mx"]) == 0 && mode != "none" {
+		return fmt.Errorf("at least one mx field is required in %s mode", mode)
+	}
+	for _, mx := range fields["mx"] {
+		if !domainRegexp.MatchString(mx) || strings.HasSuffix(mx, ".") {
+			return fmt.Errorf("the mx %s is not a host name or a wildcard host name, e.g. *.example.com", mx)
+		}
+	}
+
+	return nil
+}
+
+// isMTASTSRecord returns true if the text is an MTA-STS record, i.e. starts with the v=STSv1 tag.
+func isMTASTSRecord(text string) bool {
+	return mtaStsVersionRegexp.MatchString(text)
+}
+
+// isTLSRPTRecord returns true if the text is a TLS-RPT record, i.e. starts with the v=TLSRPTv1 tag.
+func isTLSRPTRecord(text string) bool {
+	return tlsRptVersionRegexp.MatchString(text)
+}
+
+// mailRecordName returns the name, relative to the zone, of the record with the label of the domain name.
+func mailRecordName(label string, name string) string {
+	if name == "@" {
+		return label
+	}
+	return label + "." + name
+}
@@ -0,0 +1,115 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testMTASTSPolicy = "version: STSv1\nmode: enforce\nmx: mail.pmapitest1.com\nmx: *.mx.pmapitest1.com\nmax_age: 86400\n"
+
+func TestValidateMTASTSPolicy(t *testing.T) {
+	valid := []string{
+		testMTASTSPolicy,
+		"version: STSv1\r\nmode: none\r\nmax_age: 0\r\n",
+		"version: STSv1\nmode: testing\nmx: mail.example.com\nmax_age: 604800\nextension: ignored\n",
+	}
+	for _, policy := range valid {
+		if err := validateMTASTSPolicy(policy); err != nil {
+			t.Errorf("unexpected error for %q: %s", policy, err)
+		}
+	}
+
+	invalid := []string{
+		"",
+		"version: STSv2\nmode: enforce\nmx: mail.example.com\nmax_age: 86400\n",
+		"version: STSv1\nmode: Enforce\nmx: mail.example.com\nmax_age: 86400\n",
+		"version: STSv1\nmode: enforce\nmax_age: 86400\n",
+		"version: STSv1\nmode: enforce\nmx: mail.example.com\nmax_age: 31557601\n",
+		"version: STSv1\nmode: enforce\nmx: mail.example.com\nmax_age: 86400\nmax_age: 3600\n",
+		"version: STSv1\nmode: enforce\nmx: mail..example.com\nmax_age: 86400\n",
+		"version STSv1\nmode: enforce\nmx: mail.example.com\nmax_age: 86400\n",
+	}
+	for _, policy := range invalid {
+		if err := validateMTASTSPolicy(policy); err == nil {
+			t.Errorf("expected an error for %q", policy)
+		}
+	}
+}
+
+func TestMTASTSID(t *testing.T) {
+	id := mtaStsID(types.StringValue(testMTASTSPolicy), types.StringNull())
+	if len(id) != 32 {
+		t.Errorf("expected an id of 32 characters, got %s", id)
+	}
+	if changed := mtaStsID(types.StringValue(testMTASTSPolicy+"\n"), types.StringNull()); changed == id {
+		t.Errorf("expected the id to change with the policy")
+	}
+
+	if id := mtaStsID(types.StringNull(), types.StringValue("0123456789ABCDEF")); id != "0123456789abcdef" {
+		t.Errorf("unexpected id of a short hash %s", id)
+	}
+
+	if id, err := parseMTASTSRecord(mtaStsRecordText("abc123")); err != nil || id != "abc123" {
+		t.Errorf("unexpected id %s, %v", id, err)
+	}
+
+	rua := []string{"mailto:tls@example.com", "https://reports.example.com/tls"}
+	if parsed, err := parseTLSRPTRecord(tlsRptRecordText(rua)); err != nil || len(parsed) != 2 || parsed[1] != rua[1] {
+		t.Errorf("unexpected report URIs %v, %v", parsed, err)
+	}
+}
+
+func TestAccDnsMtaStsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_mta_sts" "test" {
+			  zone   = "pmapitest1.com"
+			  policy = <<-EOT
+` + testMTASTSPolicy + `EOT
+			  rua    = ["mailto:tls-reports@pmapitest1.com"]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_mta_sts.test", "name", "@"),
+					resource.TestCheckResourceAttrSet("abion_dns_mta_sts.test", "policy_id"),
+					resource.TestCheckResourceAttr("abion_dns_mta_sts.test", "tls_rpt_value", "v=TLSRPTv1; rua=mailto:tls-reports@pmapitest1.com"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_mta_sts.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/@",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+				ImportStateVerifyIgnore:              []string{"policy"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_mta_sts" "test" {
+			  zone        = "pmapitest1.com"
+			  policy_hash = "8f434346648f6b96df89dda901c5176b10a6d83961dd3c1ac88b59b2dc327aa4"
+			  rua         = ["mailto:tls-reports@pmapitest1.com", "https://reports.pmapitest1.com/tls"]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_mta_sts.test", "policy_id", "8f434346648f6b96df89dda901c5176b"),
+					resource.TestCheckResourceAttr("abion_dns_mta_sts.test", "mta_sts_value", "v=STSv1; id=8f434346648f6b96df89dda901c5176b"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
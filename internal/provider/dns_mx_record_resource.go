@@ -6,6 +6,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,6 +23,7 @@ var (
 	_ resource.Resource                = &dnsMXRecordResource{}
 	_ resource.ResourceWithConfigure   = &dnsMXRecordResource{}
 	_ resource.ResourceWithImportState = &dnsMXRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsMXRecordResource{}
 )
 
 // NewDnsMXRecordResource is a helper function to simplify the provider implementation.
@@ -30,8 +33,16 @@ func NewDnsMXRecordResource() resource.Resource {
 
 // dnsMXRecordResource is the resource implementation.
 type dnsMXRecordResource struct {
-	client     *abionclient.Client
-	recordType utils.RecordType
+	client       *abionclient.Client
+	providerData *AbionProviderData
+	recordType   utils.RecordType
+}
+
+// dnsMXRecordResourceModel maps the resource schema data.
+type dnsMXRecordResourceModel struct {
+	dnsMXRecordModel
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *dnsMXRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,16 +52,17 @@ func (r *dnsMXRecordResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*abionclient.Client)
+	providerData, ok := req.ProviderData.(*AbionProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *abionclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.Client
+	r.providerData = providerData
 	r.recordType = utils.RecordTypeMX
 }
 
@@ -60,7 +72,7 @@ func (r *dnsMXRecordResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *dnsMXRecordResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *dnsMXRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Use this resource to create, update and delete DNS MX records of a zone.",
 		Attributes: map[string]schema.Attribute{
@@ -86,30 +98,67 @@ func (r *dnsMXRecordResource) Schema(_ context.Context, _ resource.SchemaRequest
 							Description: "The priority in which order mail servers are tried.",
 						},
 						"ttl": schema.Int32Attribute{
-							Optional:    true,
-							Description: "Time-to-live (TTL) for the record, in seconds.",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
 						},
 						"comments": schema.StringAttribute{
-							Optional:    true,
-							Description: "Comments for the record.",
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
 						},
 					},
 				},
 			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
 
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsMXRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRecordDefaults(ctx, r.providerData, path.Root("records"), req, resp)
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *dnsMXRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var plan dnsMXRecordModel
+	var plan dnsMXRecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := r.createMXRecordCreateUpdateRequest(plan)
 
 	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
@@ -134,19 +183,31 @@ func (r *dnsMXRecordResource) Create(ctx context.Context, req resource.CreateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (r *dnsMXRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 
 	// Get current state
-	var state dnsMXRecordModel
+	var state dnsMXRecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	// Get the zone details from Abion API
 	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
 	if err != nil {
@@ -188,7 +249,7 @@ func (r *dnsMXRecordResource) Read(ctx context.Context, req resource.ReadRequest
 // Update updates the resource and sets the updated Terraform state on success.
 func (r *dnsMXRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Retrieve values from plan
-	var plan dnsMXRecordModel
+	var plan dnsMXRecordResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -196,13 +257,28 @@ func (r *dnsMXRecordResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	// Retrieve values from current state
-	var state dnsMXRecordModel
+	var state dnsMXRecordResourceModel
 	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// update or add the new records according to the plan
 	patchRequest := r.createMXRecordCreateUpdateRequest(plan)
 
@@ -236,18 +312,36 @@ func (r *dnsMXRecordResource) Update(ctx context.Context, req resource.UpdateReq
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *dnsMXRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	// Retrieve values from state
-	var state dnsMXRecordModel
+	var state dnsMXRecordResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), r.recordType, nil)
 
 	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
@@ -271,7 +365,7 @@ func (r *dnsMXRecordResource) ImportState(ctx context.Context, req resource.Impo
 	importState(ctx, req, resp)
 }
 
-func (r *dnsMXRecordResource) createMXRecordCreateUpdateRequest(plan dnsMXRecordModel) abionclient.ZoneRequest {
+func (r *dnsMXRecordResource) createMXRecordCreateUpdateRequest(plan dnsMXRecordResourceModel) abionclient.ZoneRequest {
 	var data []abionclient.Record
 	for _, record := range plan.Records {
 		record := abionclient.Record{
@@ -169,3 +169,58 @@ func TestAccDnsMXRecordNonExistingZoneResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDnsMXRecordTimeoutsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing with operation timeouts
+			{
+				Config: providerConfig + `
+			resource "abion_dns_mx_record" "test" {
+			  zone  = "pmapitest4.com"
+			  name = "timeouts"
+			  records = [
+				{
+				  host     = "mail1.pmapitest4.com."
+				  priority = "10"
+				},
+			  ]
+			  timeouts {
+				create = "30m"
+				read   = "2m"
+				update = "30m"
+				delete = "10m"
+			  }
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_mx_record.test", "records.#", "1"),
+					resource.TestCheckResourceAttr("abion_dns_mx_record.test", "timeouts.create", "30m"),
+					resource.TestCheckResourceAttr("abion_dns_mx_record.test", "timeouts.read", "2m"),
+				),
+			},
+			// Verify invalid timeout durations are rejected
+			{
+				Config: providerConfig + `
+			resource "abion_dns_mx_record" "test" {
+			  zone  = "pmapitest4.com"
+			  name = "timeouts"
+			  records = [
+				{
+				  host     = "mail1.pmapitest4.com."
+				  priority = "10"
+				},
+			  ]
+			  timeouts {
+				create = "thirty minutes"
+			  }
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Time Duration`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
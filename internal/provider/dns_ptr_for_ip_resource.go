@@ -0,0 +1,481 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"net/netip"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsPtrForIPResource{}
+	_ resource.ResourceWithConfigure   = &dnsPtrForIPResource{}
+	_ resource.ResourceWithImportState = &dnsPtrForIPResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsPtrForIPResource{}
+)
+
+// NewDnsPtrForIPResource is a helper function to simplify the provider implementation.
+func NewDnsPtrForIPResource() resource.Resource {
+	return &dnsPtrForIPResource{}
+}
+
+// dnsPtrForIPResource is the resource implementation.
+type dnsPtrForIPResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsPtrForIPResourceModel maps the resource schema data.
+type dnsPtrForIPResourceModel struct {
+	IPAddress          types.String   `tfsdk:"ip_address"`
+	Hostname           types.String   `tfsdk:"hostname"`
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	ReverseName        types.String   `tfsdk:"reverse_name"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsPtrForIPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsPtrForIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_ptr_for_ip"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsPtrForIPResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the PTR record of an IP address. The reverse name in " +
+			"`in-addr.arpa` or `ip6.arpa` is derived from the IP address, and the record is managed in the reverse " +
+			"zone of the account with the longest matching name.",
+		Attributes: map[string]schema.Attribute{
+			"ip_address": schema.StringAttribute{
+				Required:    true,
+				Description: "The IPv4 or IPv6 address to manage the PTR record of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					ipAddressValidator{},
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The fully qualified host name the IP address points to, e.g. `mail.example.com.`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hostnameRegexp, "must be a fully qualified host name"),
+				},
+			},
+			"zone": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: "The reverse zone of the PTR record, e.g. `2.0.192.in-addr.arpa`. Defaults to the " +
+					"zone of the account with the longest name that the reverse name is within.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Computed:    true,
+				Description: "The name of the PTR record relative to the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"reverse_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The fully qualified reverse name of the IP address, e.g. `1.2.0.192.in-addr.arpa.`.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the record to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans the record defaults and the reverse name, and validates the planned changes against the
+// allowed_zones and read_only settings of the provider.
+func (r *dnsPtrForIPResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		var ipAddress, zone types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ip_address"), &ipAddress)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if addr, err := netip.ParseAddr(ipAddress.ValueString()); !ipAddress.IsUnknown() && err == nil {
+			name := reverseName(addr)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("reverse_name"), name+".")...)
+
+			// The name in a looked up zone is planned when the resource is applied
+			if !zone.IsUnknown() {
+				relative, ok := relativeRecordName(name, zone.ValueString())
+				if !ok {
+					resp.Diagnostics.AddAttributeError(
+						path.Root("zone"),
+						"Reverse name not within zone",
+						"The reverse name "+name+" of "+ipAddress.ValueString()+" is not within the zone "+zone.ValueString()+".",
+					)
+					return
+				}
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), relative)...)
+			}
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsPtrForIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsPtrForIPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.resolveZone(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsPtrForIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsPtrForIPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// An imported resource only knows its IP address
+	if state.Zone.IsNull() || state.Name.IsNull() {
+		resp.Diagnostics.Append(r.resolveZone(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := zone.Data.Attributes.Records[state.Name.ValueString()][utils.RecordTypePTR.String()]
+
+	// The record has been removed outside of Terraform
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	record := records[0]
+	for _, candidate := range records {
+		if normalizeZoneName(candidate.Data) == normalizeZoneName(state.Hostname.ValueString()) {
+			record = candidate
+		}
+	}
+
+	// Keep the host name as configured if it only differs by case or the trailing dot
+	if normalizeZoneName(record.Data) != normalizeZoneName(state.Hostname.ValueString()) {
+		state.Hostname = types.StringValue(record.Data)
+	}
+	state.TTL = utils.IntPointerToInt32(record.TTL)
+	state.Comments = utils.StringPointerToTerraformString(record.Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsPtrForIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsPtrForIPResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsPtrForIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsPtrForIPResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	tflog.Debug(ctx, "Deleting PTR record of "+state.IPAddress.ValueString())
+
+	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), utils.RecordTypePTR, nil)
+
+	_, err := r.client.PatchZone(ctx, state.Zone.ValueString(), patchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete PTR record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the PTR record of the IP address given by the import ID.
+func (r *dnsPtrForIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if _, err := netip.ParseAddr(req.ID); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected an IPv4 or IPv6 address, e.g., `192.0.2.1` or `2001:db8::1`.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_address"), req.ID)...)
+}
+
+// resolveZone sets the reverse name, the zone, if not configured, and the name relative to the zone of the model.
+func (r *dnsPtrForIPResource) resolveZone(ctx context.Context, model *dnsPtrForIPResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	addr, err := netip.ParseAddr(model.IPAddress.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ip_address"),
+			"Invalid IP Address",
+			err.Error(),
+		)
+		return diags
+	}
+
+	name := reverseName(addr)
+	model.ReverseName = types.StringValue(name + ".")
+
+	if model.Zone.IsNull() || model.Zone.IsUnknown() {
+		zone, err := lookupZone(ctx, r.client, name)
+		if err != nil {
+			diags.AddError(
+				"Unable to Read Zones from Abion API",
+				err.Error(),
+			)
+			return diags
+		}
+		if zone == "" {
+			diags.AddAttributeError(
+				path.Root("ip_address"),
+				"Reverse zone not found",
+				"None of the zones of the account contains the reverse name "+name+" of "+model.IPAddress.ValueString()+".",
+			)
+			return diags
+		}
+		model.Zone = types.StringValue(zone)
+	}
+
+	relative, ok := relativeRecordName(name, model.Zone.ValueString())
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("zone"),
+			"Reverse name not within zone",
+			"The reverse name "+name+" of "+model.IPAddress.ValueString()+" is not within the zone "+model.Zone.ValueString()+".",
+		)
+		return diags
+	}
+	model.Name = types.StringValue(relative)
+
+	return diags
+}
+
+// put sets the PTR record of the reverse name and waits for the change to become visible.
+func (r *dnsPtrForIPResource) put(ctx context.Context, plan dnsPtrForIPResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Setting PTR record of "+plan.IPAddress.ValueString())
+
+	data := []abionclient.Record{
+		{
+			Data:     normalizeZoneName(plan.Hostname.ValueString()) + ".",
+			TTL:      utils.Int32ToIntPointer(plan.TTL),
+			Comments: plan.Comments.ValueStringPointer(),
+		},
+	}
+	patchRequest := abionclient.CreateRecordPatchRequest(plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypePTR, data)
+
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" PTR record, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the record to become visible in the zone
+	diags.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+
+	return diags
+}
+
+// reverseName returns the reverse name of the IP address in in-addr.arpa or ip6.arpa, without a trailing dot.
+func reverseName(addr netip.Addr) string {
+	var labels []string
+
+	if addr.Is4() {
+		for _, b := range addr.As4() {
+			labels = append([]string{strconv.Itoa(int(b))}, labels...)
+		}
+		return strings.Join(labels, ".") + ".in-addr.arpa"
+	}
+
+	for _, b := range addr.As16() {
+		labels = append([]string{strconv.FormatUint(uint64(b>>4), 16)}, labels...)
+		labels = append([]string{strconv.FormatUint(uint64(b&0x0f), 16)}, labels...)
+	}
+	return strings.Join(labels, ".") + ".ip6.arpa"
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"net/netip"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestReverseName(t *testing.T) {
+	tests := map[string]string{
+		"192.0.2.1":       "1.2.0.192.in-addr.arpa",
+		"10.0.0.255":      "255.0.0.10.in-addr.arpa",
+		"2001:db8::1":     "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa",
+		"2001:db8:abcd::": "0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.d.c.b.a.8.b.d.0.1.0.0.2.ip6.arpa",
+	}
+
+	for ip, expected := range tests {
+		if actual := reverseName(netip.MustParseAddr(ip)); actual != expected {
+			t.Errorf("reverseName(%s): expected %s, got %s", ip, expected, actual)
+		}
+	}
+}
+
+func TestAccDnsPtrForIPResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The test account has no reverse zone of the documentation address range
+			{
+				Config: providerConfig + `
+			resource "abion_dns_ptr_for_ip" "test" {
+			  ip_address = "192.0.2.1"
+			  hostname   = "mail.pmapitest1.com."
+			}
+			`,
+				ExpectError: regexp.MustCompile("Reverse zone not found"),
+			},
+			// An explicit zone must contain the reverse name
+			{
+				Config: providerConfig + `
+			resource "abion_dns_ptr_for_ip" "test" {
+			  ip_address = "2001:db8::1"
+			  zone       = "pmapitest1.com"
+			  hostname   = "mail.pmapitest1.com."
+			}
+			`,
+				ExpectError: regexp.MustCompile("Reverse name not within zone"),
+			},
+		},
+	})
+}
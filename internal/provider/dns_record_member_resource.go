@@ -0,0 +1,355 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsRecordMemberResource{}
+	_ resource.ResourceWithConfigure   = &dnsRecordMemberResource{}
+	_ resource.ResourceWithImportState = &dnsRecordMemberResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsRecordMemberResource{}
+)
+
+// NewDnsRecordMemberResource is a helper function to simplify the provider implementation.
+func NewDnsRecordMemberResource() resource.Resource {
+	return &dnsRecordMemberResource{}
+}
+
+// dnsRecordMemberResource is the resource implementation.
+type dnsRecordMemberResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsRecordMemberResourceModel maps the resource schema data.
+type dnsRecordMemberResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	RData              types.String   `tfsdk:"rdata"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsRecordMemberResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsRecordMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsRecordMemberResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to add a single record to the records of a name and type, without " +
+			"managing the other records of the name and type. Several members, and records created outside of " +
+			"Terraform, can coexist in the same record set. Don't combine with a resource managing the whole record " +
+			"set, e.g. `abion_dns_txt_record`, of the same name and type.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the record belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name to add the record to. For example `@`, `www`, `ftp`, `www.east`. The `@` character represents the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The record type in upper case. For example `A`, `TXT`, `MX`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(recordTypeRegexp, "must be a record type in upper case"),
+				},
+			},
+			"rdata": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The record data in the presentation format of the record type, e.g. `google-site-verification=abc` for a TXT record. The record is identified by its data within the record set.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the record to become visible in the zone before finishing create and update, " +
+					"and to disappear before finishing delete. Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsRecordMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+	}
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create adds the record to the record set and sets the initial Terraform state.
+func (r *dnsRecordMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsRecordMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data of the record owned by the resource.
+func (r *dnsRecordMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsRecordMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	record, err := readRecordMember(ctx, r.client, state.Zone.ValueString(), state.Name.ValueString(), utils.RecordType(state.Type.ValueString()), state.RData.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	// The record has been removed outside of Terraform
+	if record == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.TTL = utils.IntPointerToInt32(record.TTL)
+	state.Comments = utils.StringPointerToTerraformString(record.Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the ttl and comments of the record and sets the updated Terraform state on success.
+func (r *dnsRecordMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsRecordMemberResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the record from the record set and removes the Terraform state on success.
+func (r *dnsRecordMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsRecordMemberResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	ctx = tflog.SetField(ctx, "record_type", state.Type.ValueString())
+	tflog.Debug(ctx, "Deleting zone "+state.Type.ValueString()+" record member")
+
+	err := removeRecordMember(ctx, r.client, state.Zone.ValueString(), state.Name.ValueString(), utils.RecordType(state.Type.ValueString()), state.RData.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Wait for the record to disappear from the zone, ignoring the other members of the record set
+	resp.Diagnostics.Append(waitForRecordMembers(ctx, r.providerData, state.WaitForConsistency, state.Zone.ValueString(), state.Name.ValueString(), utils.RecordType(state.Type.ValueString()), nil, []string{state.RData.ValueString()})...)
+}
+
+func (r *dnsRecordMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Expect the import ID to be in the format: "zone/name/type/rdata"
+	// e.g., "example.com/@/TXT/google-site-verification=abc", the rdata may contain slashes
+	parts := strings.SplitN(req.ID, "/", 4)
+
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid import ID format",
+			"Expected format `zone/name/type/rdata`, e.g., `example.com/@/TXT/google-site-verification=abc`.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("type"), strings.ToUpper(parts[2]))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("rdata"), parts[3])...)
+}
+
+// put adds or updates the record in the record set and waits for the change to become visible.
+func (r *dnsRecordMemberResource) put(ctx context.Context, plan dnsRecordMemberResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	record := abionclient.Record{
+		Data:     plan.RData.ValueString(),
+		TTL:      utils.Int32ToIntPointer(plan.TTL),
+		Comments: plan.Comments.ValueStringPointer(),
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	ctx = tflog.SetField(ctx, "record_type", plan.Type.ValueString())
+	tflog.Debug(ctx, "Putting zone "+plan.Type.ValueString()+" record member")
+
+	_, err := putRecordMember(ctx, r.client, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordType(plan.Type.ValueString()), record)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" record, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the record to become visible in the zone, ignoring the other members of the record set
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordType(plan.Type.ValueString()), []string{record.Data}, nil)...)
+
+	return diags
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsRecordMemberResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, two members of the same record set
+			{
+				Config: providerConfig + `
+			resource "abion_dns_record_member" "verification" {
+			  zone  = "pmapitest1.com"
+			  name  = "member"
+			  type  = "TXT"
+			  rdata = "google-site-verification=test"
+			}
+
+			resource "abion_dns_record_member" "spf" {
+			  zone     = "pmapitest1.com"
+			  name     = "member"
+			  type     = "TXT"
+			  rdata    = "v=spf1 -all"
+			  ttl      = 3600
+			  comments = "test comment"
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_record_member.verification", "rdata", "google-site-verification=test"),
+					resource.TestCheckNoResourceAttr("abion_dns_record_member.verification", "ttl"),
+					resource.TestCheckResourceAttr("abion_dns_record_member.spf", "rdata", "v=spf1 -all"),
+					resource.TestCheckResourceAttr("abion_dns_record_member.spf", "ttl", "3600"),
+					resource.TestCheckResourceAttr("abion_dns_record_member.spf", "comments", "test comment"),
+				),
+			},
+			// Update and Read testing, remove one member and update the other
+			{
+				Config: providerConfig + `
+			resource "abion_dns_record_member" "spf" {
+			  zone  = "pmapitest1.com"
+			  name  = "member"
+			  type  = "TXT"
+			  rdata = "v=spf1 -all"
+			  ttl   = 7200
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_record_member.spf", "rdata", "v=spf1 -all"),
+					resource.TestCheckResourceAttr("abion_dns_record_member.spf", "ttl", "7200"),
+					resource.TestCheckNoResourceAttr("abion_dns_record_member.spf", "comments"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_record_member.spf",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/member/TXT/v=spf1 -all",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "rdata",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,387 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsRecordResource{}
+	_ resource.ResourceWithConfigure   = &dnsRecordResource{}
+	_ resource.ResourceWithImportState = &dnsRecordResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsRecordResource{}
+)
+
+// NewDnsRecordResource is a helper function to simplify the provider implementation.
+func NewDnsRecordResource() resource.Resource {
+	return &dnsRecordResource{}
+}
+
+// dnsRecordResource is the resource implementation.
+type dnsRecordResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsRecordResourceModel maps the resource schema data.
+type dnsRecordResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	Type               types.String   `tfsdk:"type"`
+	Records            []RecordData   `tfsdk:"records"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+type RecordData struct {
+	RData    types.String `tfsdk:"rdata"`
+	TTL      types.Int32  `tfsdk:"ttl"`
+	Comments types.String `tfsdk:"comments"`
+}
+
+func (r *dnsRecordResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsRecordResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_record"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsRecordResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to create, update and delete DNS records of any type of a zone. The record " +
+			"data is sent to the Abion API as is, so it must be in the presentation format of the record type. Prefer the " +
+			"resources of the specific record types, e.g. `abion_dns_a_record`, where they exist.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the record belongs to.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name to create records for. For example `@`, `www`, `ftp`, `www.east`. The `@` character represents the root of the zone.",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The record type in upper case. For example `A`, `TXT`, `TLSA`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(recordTypeRegexp, "must be a record type in upper case"),
+				},
+			},
+			"records": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The list of records.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rdata": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "The record data in the presentation format of the record type, e.g. `10 mail.example.com.` for an MX record.",
+						},
+						"ttl": schema.Int32Attribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+						},
+						"comments": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+						},
+					},
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanRecordDefaults(ctx, r.providerData, path.Root("records"), req, resp)
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsRecordResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := r.createRecordCreateUpdateRequest(plan)
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	ctx = tflog.SetField(ctx, "record_type", plan.Type.ValueString())
+	tflog.Debug(ctx, "Creating zone "+plan.Type.ValueString()+" record")
+
+	// Update zone by adding the record
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not create record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsRecordResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	recordTypes := zone.Data.Attributes.Records[state.Name.ValueString()]
+
+	state.Records = []RecordData{}
+	if len(recordTypes) > 0 {
+		records := recordTypes[state.Type.ValueString()]
+
+		for _, record := range records {
+			state.Records = append(state.Records, RecordData{
+				RData:    types.StringValue(record.Data),
+				Comments: utils.StringPointerToTerraformString(record.Comments),
+				TTL:      utils.IntPointerToInt32(record.TTL),
+			})
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsRecordResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from current state
+	var state dnsRecordResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := r.createRecordCreateUpdateRequest(plan)
+
+	if plan.Name.ValueString() != state.Name.ValueString() || plan.Type.ValueString() != state.Type.ValueString() {
+		// records have been moved to another level or type, remove the records of the old state level and type
+		if patchRequest.Data.Attributes.Records[state.Name.ValueString()] == nil {
+			patchRequest.Data.Attributes.Records[state.Name.ValueString()] = make(map[string][]abionclient.Record)
+		}
+		patchRequest.Data.Attributes.Records[state.Name.ValueString()][state.Type.ValueString()] = nil
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	ctx = tflog.SetField(ctx, "record_type", plan.Type.ValueString())
+	tflog.Debug(ctx, "Updating zone "+plan.Type.ValueString()+" record")
+
+	// Update zone by adding the record
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not update record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the records to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsRecordResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordType := utils.RecordType(state.Type.ValueString())
+	patchRequest := abionclient.CreateRecordPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), recordType, nil)
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	ctx = tflog.SetField(ctx, "record_type", recordType.String())
+	tflog.Debug(ctx, "Deleting zone "+recordType.String()+" record")
+
+	// Update zone by removing the record
+	_, err := r.client.PatchZone(ctx, state.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importRecordState(ctx, req, resp)
+}
+
+func (r *dnsRecordResource) createRecordCreateUpdateRequest(plan dnsRecordResourceModel) abionclient.ZoneRequest {
+	var data []abionclient.Record
+	for _, record := range plan.Records {
+		record := abionclient.Record{
+			Data:     record.RData.ValueString(),
+			TTL:      utils.Int32ToIntPointer(record.TTL),
+			Comments: record.Comments.ValueStringPointer(),
+		}
+		data = append(data, record)
+	}
+
+	recordType := utils.RecordType(plan.Type.ValueString())
+	return abionclient.CreateRecordPatchRequest(plan.Zone.ValueString(), plan.Name.ValueString(), recordType, data)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsRecordResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_record" "test" {
+			  zone = "pmapitest1.com"
+			  name = "generic"
+			  type = "TXT"
+			  records = [
+				{
+				  rdata    = "generic record"
+				  ttl      = 3600
+				  comments = "test comment"
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_record.test", "zone", "pmapitest1.com"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "name", "generic"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "type", "TXT"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.#", "1"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.0.rdata", "generic record"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.0.ttl", "3600"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.0.comments", "test comment"),
+				),
+			},
+			// Update and Read testing, change type and level
+			{
+				Config: providerConfig + `
+			resource "abion_dns_record" "test" {
+			  zone = "pmapitest1.com"
+			  name = "generic2"
+			  type = "A"
+			  records = [
+				{
+				  rdata = "203.0.113.0"
+				},
+				{
+				  rdata = "203.0.113.1"
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_record.test", "name", "generic2"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "type", "A"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.#", "2"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.0.rdata", "203.0.113.0"),
+					resource.TestCheckNoResourceAttr("abion_dns_record.test", "records.0.ttl"),
+					resource.TestCheckResourceAttr("abion_dns_record.test", "records.1.rdata", "203.0.113.1"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_record.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/generic2/A",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsRecordInvalidTypeResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify invalid record type
+			{
+				Config: providerConfig + `
+			resource "abion_dns_record" "test" {
+			  zone = "pmapitest1.com"
+			  name = "generic"
+			  type = "txt"
+			  records = [
+				{
+				  rdata = "generic record"
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile("must be a record type in upper case"),
+			},
+		},
+	})
+}
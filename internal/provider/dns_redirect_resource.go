@@ -0,0 +1,416 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsRedirectResource{}
+	_ resource.ResourceWithConfigure   = &dnsRedirectResource{}
+	_ resource.ResourceWithImportState = &dnsRedirectResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsRedirectResource{}
+)
+
+// NewDnsRedirectResource is a helper function to simplify the provider implementation.
+func NewDnsRedirectResource() resource.Resource {
+	return &dnsRedirectResource{}
+}
+
+// dnsRedirectResource is the resource implementation.
+type dnsRedirectResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsRedirectModel maps the redirects of a name in a zone.
+type dnsRedirectModel struct {
+	Zone      types.String   `tfsdk:"zone"`
+	Name      types.String   `tfsdk:"name"`
+	Redirects []RedirectData `tfsdk:"redirects"`
+}
+
+type RedirectData struct {
+	Path        types.String `tfsdk:"path"`
+	Destination types.String `tfsdk:"destination"`
+	Status      types.Int32  `tfsdk:"status"`
+	Slugs       types.Bool   `tfsdk:"slugs"`
+	Certificate types.Bool   `tfsdk:"certificate"`
+}
+
+// dnsRedirectResourceModel maps the resource schema data.
+type dnsRedirectResourceModel struct {
+	dnsRedirectModel
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsRedirectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsRedirectResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_redirect"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsRedirectResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this resource to create, update and delete web redirects of a name in a zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the redirects belong to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name to create redirects for. For example `@`, `www`, `shop`. The `@` character represents the root of the zone.",
+			},
+			"redirects": schema.ListNestedAttribute{
+				Description: "List of redirect rules of the name.",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path of the requests to redirect, e.g. `/campaign`. Redirects all requests of the name if not set.",
+							Optional:            true,
+						},
+						"destination": schema.StringAttribute{
+							Description: "The absolute http or https URL to redirect to.",
+							Required:    true,
+							Validators: []validator.String{
+								urlValidator{},
+							},
+						},
+						"status": schema.Int32Attribute{
+							MarkdownDescription: "The HTTP status code of the redirect, one of `301`, `302`, `307` or `308`. Defaults to `301`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             int32default.StaticInt32(301),
+							Validators: []validator.Int32{
+								int32validator.OneOf(301, 302, 307, 308),
+							},
+						},
+						"slugs": schema.BoolAttribute{
+							MarkdownDescription: "Whether the path of the request is appended to the destination. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+						"certificate": schema.BoolAttribute{
+							MarkdownDescription: "Whether a certificate is issued for the name, so that https requests are redirected too. Defaults to `false`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the redirects to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan validates the planned changes against the allowed_zones and read_only settings of the provider.
+func (r *dnsRedirectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsRedirectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsRedirectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := r.createRedirectCreateUpdateRequest(plan)
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Creating zone redirects")
+
+	// Update zone by adding the redirects
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not create redirects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the redirects to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsRedirectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsRedirectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	redirects := zone.Data.Attributes.Redirects[state.Name.ValueString()]
+
+	// The redirects have been removed outside of Terraform
+	if len(redirects) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Redirects = toRedirectData(redirects)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsRedirectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsRedirectResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Retrieve values from current state
+	var state dnsRedirectResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := r.createRedirectCreateUpdateRequest(plan)
+
+	if plan.Name.ValueString() != state.Name.ValueString() {
+		// redirects have been moved from one name to another, remove the redirects from the old state name
+		patchRequest.Data.Attributes.Redirects[state.Name.ValueString()] = nil
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Updating zone redirects")
+
+	// Update zone by replacing the redirects
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not update redirects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the redirects to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsRedirectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsRedirectResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := abionclient.CreateRedirectPatchRequest(state.Zone.ValueString(), state.Name.ValueString(), nil)
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	tflog.Debug(ctx, "Deleting zone redirects")
+
+	// Update zone by removing the redirects
+	_, err := r.client.PatchZone(ctx, state.Zone.ValueString(), patchRequest)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete redirects, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (r *dnsRedirectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+func (r *dnsRedirectResource) createRedirectCreateUpdateRequest(plan dnsRedirectResourceModel) abionclient.ZoneRequest {
+	var data []abionclient.Redirect
+
+	for _, redirect := range plan.Redirects {
+		data = append(data, abionclient.Redirect{
+			Path:        redirect.Path.ValueString(),
+			Destination: redirect.Destination.ValueString(),
+			Status:      int(redirect.Status.ValueInt32()),
+			Slugs:       redirect.Slugs.ValueBool(),
+			Certificate: redirect.Certificate.ValueBool(),
+		})
+	}
+
+	return abionclient.CreateRedirectPatchRequest(plan.Zone.ValueString(), plan.Name.ValueString(), data)
+}
+
+// toRedirectData returns the redirects of the Abion API as resource and data source data.
+func toRedirectData(redirects []abionclient.Redirect) []RedirectData {
+	var data []RedirectData
+
+	for _, redirect := range redirects {
+		data = append(data, RedirectData{
+			Path:        utils.StringToTerraformString(redirect.Path),
+			Destination: types.StringValue(redirect.Destination),
+			Status:      types.Int32Value(int32(redirect.Status)),
+			Slugs:       types.BoolValue(redirect.Slugs),
+			Certificate: types.BoolValue(redirect.Certificate),
+		})
+	}
+
+	return data
+}
@@ -0,0 +1,125 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsRedirectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing on root level
+			{
+				Config: providerConfig + `
+			resource "abion_dns_redirect" "test" {
+			  zone = "pmapitest2.com"
+			  name = "@"
+			  redirects = [
+				{
+				  destination = "https://www.test.com/"
+				},
+				{
+				  path        = "/shop"
+				  destination = "https://shop.test.com/"
+				  status      = 302
+				  slugs       = true
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "zone", "pmapitest2.com"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "name", "@"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.#", "2"),
+					resource.TestCheckNoResourceAttr("abion_dns_redirect.test", "redirects.0.path"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.destination", "https://www.test.com/"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.status", "301"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.slugs", "false"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.certificate", "false"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.1.path", "/shop"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.1.destination", "https://shop.test.com/"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.1.status", "302"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.1.slugs", "true"),
+				),
+			},
+			// Update and Read testing, move redirects from root to subdomain
+			{
+				Config: providerConfig + `
+			resource "abion_dns_redirect" "test" {
+			  zone = "pmapitest2.com"
+			  name = "go"
+			  redirects = [
+				{
+				  destination = "https://www.test.com/campaign"
+				  status      = 308
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "zone", "pmapitest2.com"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "name", "go"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.#", "1"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.destination", "https://www.test.com/campaign"),
+					resource.TestCheckResourceAttr("abion_dns_redirect.test", "redirects.0.status", "308"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_redirect.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest2.com/go",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "name",
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsRedirectInvalidResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify invalid status code
+			{
+				Config: providerConfig + `
+			resource "abion_dns_redirect" "test" {
+			  zone = "pmapitest2.com"
+			  name = "@"
+			  redirects = [
+				{
+				  destination = "https://www.test.com/"
+				  status      = 303
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Verify invalid destination
+			{
+				Config: providerConfig + `
+			resource "abion_dns_redirect" "test" {
+			  zone = "pmapitest2.com"
+			  name = "@"
+			  redirects = [
+				{
+				  destination = "www.test.com"
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile("Invalid URL"),
+			},
+		},
+	})
+}
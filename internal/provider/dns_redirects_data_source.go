@@ -0,0 +1,178 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsRedirectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsRedirectsDataSource{}
+)
+
+// NewDnsRedirectsDataSource is a helper function to simplify the provider implementation.
+func NewDnsRedirectsDataSource() datasource.DataSource {
+	return &dnsRedirectsDataSource{}
+}
+
+// dnsRedirectsDataSource is the data source implementation.
+type dnsRedirectsDataSource struct {
+	client *abionclient.Client
+}
+
+// dnsRedirectsModel maps the data source schema data.
+type dnsRedirectsModel struct {
+	Zone      types.String        `tfsdk:"zone"`
+	Name      types.String        `tfsdk:"name"`
+	Redirects []NamedRedirectData `tfsdk:"redirects"`
+}
+
+type NamedRedirectData struct {
+	Name        types.String `tfsdk:"name"`
+	Path        types.String `tfsdk:"path"`
+	Destination types.String `tfsdk:"destination"`
+	Status      types.Int32  `tfsdk:"status"`
+	Slugs       types.Bool   `tfsdk:"slugs"`
+	Certificate types.Bool   `tfsdk:"certificate"`
+}
+
+func (d *dnsRedirectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsRedirectsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_redirects"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsRedirectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the web redirects of a zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the redirects belong to.",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only get the redirects of this name. For example `@`, `www`, `shop`. The `@` character represents the root of the zone. Gets the redirects of all names if not set.",
+			},
+			"redirects": schema.ListNestedAttribute{
+				Description: "List of redirect rules, sorted by name.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name the redirect belongs to.",
+							Computed:    true,
+						},
+						"path": schema.StringAttribute{
+							Description: "The path of the requests to redirect, null if all requests of the name are redirected.",
+							Computed:    true,
+						},
+						"destination": schema.StringAttribute{
+							Description: "The URL to redirect to.",
+							Computed:    true,
+						},
+						"status": schema.Int32Attribute{
+							Description: "The HTTP status code of the redirect.",
+							Computed:    true,
+						},
+						"slugs": schema.BoolAttribute{
+							Description: "Whether the path of the request is appended to the destination.",
+							Computed:    true,
+						},
+						"certificate": schema.BoolAttribute{
+							Description: "Whether a certificate is issued for the name, so that https requests are redirected too.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsRedirectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsRedirectsModel
+
+	// Load zone and name from the configuration into state
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Getting zone details")
+
+	// Get the zone details from Abion API
+	zone, err := d.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Zone from Abion API",
+			err.Error(),
+		)
+		return
+	}
+
+	redirects := zone.Data.Attributes.Redirects
+
+	names := make([]string, 0, len(redirects))
+	for name := range redirects {
+		if state.Name.IsNull() || state.Name.ValueString() == name {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	state.Redirects = []NamedRedirectData{}
+	for _, name := range names {
+		for _, redirect := range redirects[name] {
+			state.Redirects = append(state.Redirects, NamedRedirectData{
+				Name:        types.StringValue(name),
+				Path:        utils.StringToTerraformString(redirect.Path),
+				Destination: types.StringValue(redirect.Destination),
+				Status:      types.Int32Value(int32(redirect.Status)),
+				Slugs:       types.BoolValue(redirect.Slugs),
+				Certificate: types.BoolValue(redirect.Certificate),
+			})
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
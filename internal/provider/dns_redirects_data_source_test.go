@@ -0,0 +1,71 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsRedirectsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create redirects and verify datasource
+			{
+				Config: providerConfig + `
+			resource "abion_dns_redirect" "test" {
+			  zone = "pmapitest2.com"
+			  name = "redirectdata"
+			  redirects = [
+				{
+				  path        = "/shop"
+				  destination = "https://shop.test.com/"
+				  status      = 302
+				  slugs       = true
+				},
+			  ]
+			}
+
+			data "abion_dns_redirects" "test_data" {
+			  zone = abion_dns_redirect.test.zone
+			  name = abion_dns_redirect.test.name
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "zone", "pmapitest2.com"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "name", "redirectdata"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.#", "1"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.name", "redirectdata"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.path", "/shop"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.destination", "https://shop.test.com/"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.status", "302"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.slugs", "true"),
+					resource.TestCheckResourceAttr("data.abion_dns_redirects.test_data", "redirects.0.certificate", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsRedirectsNonExistingZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify error non existing zone
+			{
+				Config: providerConfig + `
+			data "abion_dns_redirects" "non_existing" {
+			  zone = "non_existing.com"
+			}
+			`,
+				ExpectError: regexp.MustCompile("Unable to Read Zone from Abion API"),
+			},
+		},
+	})
+}
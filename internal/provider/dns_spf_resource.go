@@ -0,0 +1,657 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsSpfResource{}
+	_ resource.ResourceWithConfigure   = &dnsSpfResource{}
+	_ resource.ResourceWithImportState = &dnsSpfResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsSpfResource{}
+)
+
+// maxSPFLookups is the maximum number of mechanisms and modifiers that cause DNS lookups, from RFC 7208.
+const maxSPFLookups = 10
+
+// spfQualifiers are the qualifiers of the all mechanism of an SPF record.
+var spfQualifiers = []string{"+", "-", "~", "?"}
+
+// spfDomainCIDRRegexp matches the target of an a or mx mechanism, a host name or @ for the domain itself, with
+// optional IPv4 and IPv6 prefix lengths of at most 32 and 128.
+var spfDomainCIDRRegexp = regexp.MustCompile(`^(@|([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.?)(/([0-9]|[12][0-9]|3[0-2]))?(//([0-9]|[1-9][0-9]|1[01][0-9]|12[0-8]))?$`)
+
+// spfDomainSpecRegexp matches a domain spec of an SPF record, which may contain macros, e.g. %{i}._spf.example.com.
+var spfDomainSpecRegexp = regexp.MustCompile(`^[a-zA-Z0-9_.%{}+=-]+$`)
+
+// spfVersionRegexp matches the version an SPF record starts with.
+var spfVersionRegexp = regexp.MustCompile(`(?i)^\s*v=spf1(\s|$)`)
+
+// NewDnsSpfResource is a helper function to simplify the provider implementation.
+func NewDnsSpfResource() resource.Resource {
+	return &dnsSpfResource{}
+}
+
+// dnsSpfResource is the resource implementation.
+type dnsSpfResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsSpfResourceModel maps the resource schema data.
+type dnsSpfResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	Name               types.String   `tfsdk:"name"`
+	IP4                []types.String `tfsdk:"ip4"`
+	IP6                []types.String `tfsdk:"ip6"`
+	A                  []types.String `tfsdk:"a"`
+	MX                 []types.String `tfsdk:"mx"`
+	Include            []types.String `tfsdk:"include"`
+	Exists             []types.String `tfsdk:"exists"`
+	Redirect           types.String   `tfsdk:"redirect"`
+	All                types.String   `tfsdk:"all"`
+	Value              types.String   `tfsdk:"value"`
+	Lookups            types.Int32    `tfsdk:"lookups"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	Comments           types.String   `tfsdk:"comments"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+// spfPolicy is the policy of an SPF record. Terms that are not set are empty.
+type spfPolicy struct {
+	IP4      []string
+	IP6      []string
+	A        []string
+	MX       []string
+	Include  []string
+	Exists   []string
+	Redirect string
+	All      string
+}
+
+func (r *dnsSpfResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsSpfResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_spf"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsSpfResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	terms := func(description string, values validator.String) schema.ListAttribute {
+		return schema.ListAttribute{
+			Optional:            true,
+			ElementType:         types.StringType,
+			MarkdownDescription: description,
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+				listvalidator.UniqueValues(),
+				listvalidator.ValueStringsAre(values),
+			},
+		}
+	}
+
+	domainCIDR := stringvalidator.RegexMatches(spfDomainCIDRRegexp, "must be a host name or @, with optional IPv4 and IPv6 prefix lengths of at most 32 and 128, e.g. @/24")
+	domainSpec := stringvalidator.RegexMatches(spfDomainSpecRegexp, "must be a domain name, which may contain macros")
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the SPF record of a domain. The record is published as a TXT " +
+			"record at the name, split into character strings of at most 255 characters. Other TXT records of the name " +
+			"are left untouched, but the resource can't be created if the name already has an SPF record; import it instead.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone the record belongs to.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("@"),
+				MarkdownDescription: "The name of the domain, relative to the zone. Defaults to `@`, the root of the zone.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ip4": terms("The IPv4 addresses or networks allowed to send mail, e.g. `192.0.2.0/24`.", ipPrefixValidator{version: 4}),
+			"ip6": terms("The IPv6 addresses or networks allowed to send mail, e.g. `2001:db8::/32`.", ipPrefixValidator{version: 6}),
+			"a": terms("The host names whose addresses are allowed to send mail. `@` is the domain itself, and prefix "+
+				"lengths can be appended, e.g. `@/24` or `mail.example.com//64`. Each causes a DNS lookup.", domainCIDR),
+			"mx": terms("The domains whose mail exchangers are allowed to send mail. `@` is the domain itself, and prefix "+
+				"lengths can be appended, e.g. `@/24`. Each causes a DNS lookup.", domainCIDR),
+			"include": terms("The domains whose SPF records are included, e.g. `_spf.google.com`. Each causes a DNS "+
+				"lookup, and the lookups of the included records count towards the limit too.", domainSpec),
+			"exists": terms("The domains, usually with macros, that allow sending mail if they have an A record, e.g. "+
+				"`%{i}._spf.example.com`. Each causes a DNS lookup.", domainSpec),
+			"redirect": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The domain whose SPF record applies if no mechanism matches. Causes a DNS lookup. Conflicts with `all`.",
+				Validators: []validator.String{
+					domainSpec,
+				},
+			},
+			"all": schema.StringAttribute{
+				Optional: true,
+				MarkdownDescription: "The qualifier of the all mechanism that ends the record, `-` to fail, `~` to soft " +
+					"fail, `?` for neutral or `+` to pass all other senders.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(spfQualifiers...),
+					stringvalidator.ConflictsWith(path.MatchRoot("redirect")),
+				},
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "The text of the SPF record, before it is split into character strings.",
+			},
+			"lookups": schema.Int32Attribute{
+				Computed: true,
+				MarkdownDescription: "The number of DNS lookups of the mechanisms and modifiers of the record, not counting " +
+					"the lookups of included records. A warning is shown if it exceeds the limit of 10.",
+			},
+			"ttl": schema.Int32Attribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+			},
+			"comments": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the record to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan plans the record defaults, the text of the record and its lookups, validates the planned changes
+// against the allowed_zones and read_only settings of the provider and verifies that a new record doesn't conflict
+// with an existing SPF record.
+func (r *dnsSpfResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		planRecordDefaults(ctx, r.providerData, path.Empty(), req, resp)
+
+		// The text is unknown until all terms are known
+		if req.Config.Raw.IsFullyKnown() {
+			var plan dnsSpfResourceModel
+			resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			policy := plan.policy()
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("value"), policy.String())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("lookups"), int32(policy.lookups()))...)
+
+			if policy.lookups() > maxSPFLookups {
+				resp.Diagnostics.AddWarning(
+					"Too many SPF lookups",
+					fmt.Sprintf("The SPF record causes %d DNS lookups, more than the limit of %d of RFC 7208, and receivers "+
+						"will fail to evaluate it. Replace a, mx and include mechanisms with ip4 and ip6 mechanisms where "+
+						"possible. The lookups of included records count towards the limit too.", policy.lookups(), maxSPFLookups),
+				)
+			}
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+
+	// A new record must not conflict with an existing SPF record, which is only looked up if the zone may be changed
+	if req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() && req.Config.Raw.IsFullyKnown() && r.providerData != nil && !resp.Diagnostics.HasError() {
+		var plan dnsSpfResourceModel
+		resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		resp.Diagnostics.Append(r.checkNoSPFRecord(ctx, plan.Zone.ValueString(), plan.Name.ValueString())...)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *dnsSpfResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsSpfResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// The record may have been created since the plan
+	resp.Diagnostics.Append(r.checkNoSPFRecord(ctx, plan.Zone.ValueString(), plan.Name.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "create")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsSpfResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsSpfResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	records := findTXTRecords(zone.Data.Attributes.Records[state.Name.ValueString()][utils.RecordTypeTXT.String()], isSPFRecord)
+
+	// The record has been removed outside of Terraform
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if len(records) > 1 {
+		resp.Diagnostics.AddWarning(
+			"Multiple SPF records",
+			"The name "+state.Name.ValueString()+" has "+strconv.Itoa(len(records))+" SPF records, and receivers "+
+				"fail to evaluate them. The value lists all of them, and the other records are removed when the record "+
+				"is applied.",
+		)
+	}
+
+	record := records[0]
+	text := zonefile.UnquoteTXT(record.Data)
+
+	// The terms of a record that can't be represented are kept as is, and the text shows the drift
+	policy, err := parseSPFPolicy(text)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Unsupported SPF record",
+			"The SPF record of "+state.Name.ValueString()+" can't be represented by the resource and is replaced when "+
+				"the record is applied: "+err.Error(),
+		)
+	} else {
+		state.setPolicy(policy)
+	}
+
+	state.Value = types.StringValue(txtRecordsText(records))
+	state.TTL = utils.IntPointerToInt32(record.TTL)
+	state.Comments = utils.StringPointerToTerraformString(record.Comments)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsSpfResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsSpfResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.put(ctx, plan, "update")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *dnsSpfResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsSpfResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", state.Name.ValueString())
+	tflog.Debug(ctx, "Deleting SPF record")
+
+	_, err := replaceTXTRecords(ctx, r.client, state.Zone.ValueString(), state.Name.ValueString(), isSPFRecord, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not delete SPF record, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the SPF record of the domain given by the import ID.
+func (r *dnsSpfResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importState(ctx, req, resp)
+}
+
+// checkNoSPFRecord verifies that the name has no SPF record, as a domain must not have more than one.
+func (r *dnsSpfResource) checkNoSPFRecord(ctx context.Context, zoneName string, name string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	zone, err := r.client.GetZone(ctx, zoneName)
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return diags
+	}
+
+	if records := findTXTRecords(zone.Data.Attributes.Records[name][utils.RecordTypeTXT.String()], isSPFRecord); len(records) > 0 {
+		diags.AddAttributeError(
+			path.Root("name"),
+			"SPF record already exists",
+			"The name "+name+" of the zone "+zoneName+" already has the SPF record "+records[0].Data+", and a domain "+
+				"must not have more than one. Import the record with the ID "+zoneName+"/"+name+" instead.",
+		)
+	}
+
+	return diags
+}
+
+// put replaces the SPF record of the name with the record of the plan and waits for the change to become visible.
+func (r *dnsSpfResource) put(ctx context.Context, plan dnsSpfResourceModel, operation string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	ctx = tflog.SetField(ctx, "name", plan.Name.ValueString())
+	tflog.Debug(ctx, "Setting SPF record")
+
+	record := abionclient.Record{
+		Data:     txtRecordData(plan.Value.ValueString()),
+		TTL:      utils.Int32ToIntPointer(plan.TTL),
+		Comments: plan.Comments.ValueStringPointer(),
+	}
+
+	_, err := replaceTXTRecords(ctx, r.client, plan.Zone.ValueString(), plan.Name.ValueString(), isSPFRecord, &record)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not "+operation+" SPF record, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the record to become visible in the zone
+	diags.Append(waitForRecordMembers(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), plan.Name.ValueString(), utils.RecordTypeTXT, []string{record.Data}, nil)...)
+
+	return diags
+}
+
+// policy returns the SPF policy of the terms of the model.
+func (m dnsSpfResourceModel) policy() spfPolicy {
+	return spfPolicy{
+		IP4:      utils.TerraformStringsToStrings(m.IP4),
+		IP6:      utils.TerraformStringsToStrings(m.IP6),
+		A:        utils.TerraformStringsToStrings(m.A),
+		MX:       utils.TerraformStringsToStrings(m.MX),
+		Include:  utils.TerraformStringsToStrings(m.Include),
+		Exists:   utils.TerraformStringsToStrings(m.Exists),
+		Redirect: m.Redirect.ValueString(),
+		All:      m.All.ValueString(),
+	}
+}
+
+// setPolicy sets the terms and the lookups of the model to the SPF policy.
+func (m *dnsSpfResourceModel) setPolicy(policy spfPolicy) {
+	m.IP4 = utils.StringsToTerraformStrings(policy.IP4)
+	m.IP6 = utils.StringsToTerraformStrings(policy.IP6)
+	m.A = utils.StringsToTerraformStrings(policy.A)
+	m.MX = utils.StringsToTerraformStrings(policy.MX)
+	m.Include = utils.StringsToTerraformStrings(policy.Include)
+	m.Exists = utils.StringsToTerraformStrings(policy.Exists)
+	m.Redirect = utils.StringToTerraformString(policy.Redirect)
+	m.All = utils.StringToTerraformString(policy.All)
+	m.Lookups = types.Int32Value(int32(policy.lookups()))
+}
+
+// String returns the text of the SPF record of the policy. The mechanisms that don't cause DNS lookups come first,
+// so that receivers can evaluate them without lookups.
+func (p spfPolicy) String() string {
+	terms := []string{"v=spf1"}
+
+	for _, value := range p.IP4 {
+		terms = append(terms, "ip4:"+value)
+	}
+	for _, value := range p.IP6 {
+		terms = append(terms, "ip6:"+value)
+	}
+	for _, value := range p.A {
+		terms = append(terms, spfDomainCIDRTerm("a", value))
+	}
+	for _, value := range p.MX {
+		terms = append(terms, spfDomainCIDRTerm("mx", value))
+	}
+	for _, value := range p.Include {
+		terms = append(terms, "include:"+value)
+	}
+	for _, value := range p.Exists {
+		terms = append(terms, "exists:"+value)
+	}
+	if p.Redirect != "" {
+		terms = append(terms, "redirect="+p.Redirect)
+	}
+	if p.All != "" {
+		terms = append(terms, p.All+"all")
+	}
+
+	return strings.Join(terms, " ")
+}
+
+// lookups returns the number of mechanisms and modifiers of the policy that cause DNS lookups.
+func (p spfPolicy) lookups() int {
+	lookups := len(p.A) + len(p.MX) + len(p.Include) + len(p.Exists)
+	if p.Redirect != "" {
+		lookups++
+	}
+	return lookups
+}
+
+// parseSPFPolicy parses the text of an SPF record. Only the terms that can be represented by the policy are
+// supported, i.e. no ptr mechanisms, no exp modifiers and no qualifiers other than on the all mechanism.
+func parseSPFPolicy(text string) (spfPolicy, error) {
+	var policy spfPolicy
+
+	terms := strings.Fields(text)
+	if len(terms) == 0 || !strings.EqualFold(terms[0], "v=spf1") {
+		return policy, errors.New("the record doesn't start with v=spf1")
+	}
+
+	for _, term := range terms[1:] {
+		lower := strings.ToLower(term)
+
+		if name, _, found := strings.Cut(lower, "="); found && !strings.ContainsAny(name, ":/") {
+			if name != "redirect" {
+				return policy, fmt.Errorf("the modifier %s is not supported", term)
+			}
+			policy.Redirect = term[len(name)+1:]
+			continue
+		}
+
+		qualifier := ""
+		if strings.ContainsAny(lower[:1], "+-~?") {
+			qualifier, lower, term = lower[:1], lower[1:], term[1:]
+		}
+
+		if lower == "all" {
+			if qualifier == "" {
+				qualifier = "+"
+			}
+			policy.All = qualifier
+			continue
+		}
+
+		if qualifier != "" && qualifier != "+" {
+			return policy, fmt.Errorf("the qualifier of the mechanism %s is not supported", qualifier+term)
+		}
+
+		mechanism, value, _ := strings.Cut(term, ":")
+		switch {
+		case strings.EqualFold(mechanism, "ip4"):
+			policy.IP4 = append(policy.IP4, value)
+		case strings.EqualFold(mechanism, "ip6"):
+			policy.IP6 = append(policy.IP6, value)
+		case strings.EqualFold(mechanism, "include"):
+			policy.Include = append(policy.Include, value)
+		case strings.EqualFold(mechanism, "exists"):
+			policy.Exists = append(policy.Exists, value)
+		case lower == "a" || strings.HasPrefix(lower, "a:") || strings.HasPrefix(lower, "a/"):
+			policy.A = append(policy.A, parseSPFDomainCIDRTerm(term[1:]))
+		case lower == "mx" || strings.HasPrefix(lower, "mx:") || strings.HasPrefix(lower, "mx/"):
+			policy.MX = append(policy.MX, parseSPFDomainCIDRTerm(term[2:]))
+		default:
+			return policy, fmt.Errorf("the mechanism %s is not supported", term)
+		}
+	}
+
+	return policy, nil
+}
+
+// spfDomainCIDRTerm returns the a or mx mechanism of the target, where @ is the domain itself.
+func spfDomainCIDRTerm(mechanism string, target string) string {
+	if rest, found := strings.CutPrefix(target, "@"); found {
+		return mechanism + rest
+	}
+	return mechanism + ":" + target
+}
+
+// parseSPFDomainCIDRTerm returns the target of the rest of an a or mx mechanism after the mechanism name.
+func parseSPFDomainCIDRTerm(rest string) string {
+	if target, found := strings.CutPrefix(rest, ":"); found {
+		return target
+	}
+	return "@" + rest
+}
+
+// isSPFRecord returns true if the text is an SPF record, i.e. starts with v=spf1.
+func isSPFRecord(text string) bool {
+	return spfVersionRegexp.MatchString(text)
+}
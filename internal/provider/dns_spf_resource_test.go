@@ -0,0 +1,163 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+func TestSPFPolicy(t *testing.T) {
+	policy := spfPolicy{
+		IP4:      []string{"192.0.2.0/24", "198.51.100.1"},
+		IP6:      []string{"2001:db8::/32"},
+		A:        []string{"@", "@/24", "mail.example.com//64"},
+		MX:       []string{"@"},
+		Include:  []string{"_spf.google.com"},
+		Exists:   []string{"%{i}._spf.example.com"},
+		Redirect: "",
+		All:      "~",
+	}
+
+	expected := "v=spf1 ip4:192.0.2.0/24 ip4:198.51.100.1 ip6:2001:db8::/32 a a/24 a:mail.example.com//64 mx " +
+		"include:_spf.google.com exists:%{i}._spf.example.com ~all"
+	if text := policy.String(); text != expected {
+		t.Errorf("unexpected record %s", text)
+	}
+	if lookups := policy.lookups(); lookups != 6 {
+		t.Errorf("expected 6 lookups, got %d", lookups)
+	}
+
+	parsed, err := parseSPFPolicy(expected)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !reflect.DeepEqual(parsed, policy) {
+		t.Errorf("unexpected policy %+v", parsed)
+	}
+
+	if parsed, err := parseSPFPolicy("V=SPF1 +mx -all"); err != nil || parsed.All != "-" || parsed.MX[0] != "@" {
+		t.Errorf("unexpected policy %+v, %v", parsed, err)
+	}
+	if parsed, err := parseSPFPolicy("v=spf1 redirect=_spf.example.com"); err != nil || parsed.Redirect != "_spf.example.com" || parsed.lookups() != 1 {
+		t.Errorf("unexpected policy %+v, %v", parsed, err)
+	}
+
+	for _, text := range []string{"", "v=spf2 -all", "v=spf1 ptr -all", "v=spf1 -include:example.com", "v=spf1 exp=explain.example.com -all"} {
+		if _, err := parseSPFPolicy(text); err == nil {
+			t.Errorf("expected an error for %q", text)
+		}
+	}
+
+	if !isSPFRecord("v=spf1 -all") || !isSPFRecord("v=spf1") || isSPFRecord("v=spf10 -all") || isSPFRecord("v=DMARC1; p=none") {
+		t.Errorf("unexpected SPF record detection")
+	}
+}
+
+func TestSPFDomainCIDRRegexp(t *testing.T) {
+	for _, valid := range []string{"@", "@/0", "@/32", "mail.example.com//128", "mail.example.com/24//64", "@//0"} {
+		if !spfDomainCIDRRegexp.MatchString(valid) {
+			t.Errorf("expected %s to be valid", valid)
+		}
+	}
+
+	for _, invalid := range []string{"@/33", "@/99", "@/024", "@//129", "@//999", "@//064", "bad..host/24"} {
+		if spfDomainCIDRRegexp.MatchString(invalid) {
+			t.Errorf("expected %s to be invalid", invalid)
+		}
+	}
+}
+
+func TestSPFPolicyLongRecord(t *testing.T) {
+	var policy spfPolicy
+	for i := 0; i < 20; i++ {
+		policy.IP6 = append(policy.IP6, "2001:db8:"+strings.Repeat("a", 4)+"::/48")
+	}
+	policy.All = "-"
+
+	data := txtRecordData(policy.String())
+	if !strings.HasPrefix(data, `"`) {
+		t.Fatalf("expected a record split into character strings, got %s", data)
+	}
+	if text := zonefile.UnquoteTXT(data); text != policy.String() {
+		t.Errorf("unexpected joined record %s", text)
+	}
+}
+
+func TestAccDnsSpfResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Validation testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_spf" "test" {
+			  zone     = "pmapitest1.com"
+			  redirect = "_spf.pmapitest1.com"
+			  all      = "-"
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+			{
+				Config: providerConfig + `
+			resource "abion_dns_spf" "test" {
+			  zone = "pmapitest1.com"
+			  ip4  = ["2001:db8::1"]
+			}
+			`,
+				ExpectError: regexp.MustCompile(`IPv4 address or network`),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_spf" "test" {
+			  zone    = "pmapitest1.com"
+			  name    = "spf"
+			  ip4     = ["192.0.2.0/24"]
+			  mx      = ["@"]
+			  include = ["_spf.google.com"]
+			  all     = "~"
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_spf.test", "value", "v=spf1 ip4:192.0.2.0/24 mx include:_spf.google.com ~all"),
+					resource.TestCheckResourceAttr("abion_dns_spf.test", "lookups", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_spf.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest1.com/spf",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_spf" "test" {
+			  zone = "pmapitest1.com"
+			  name = "spf"
+			  ip4  = ["192.0.2.0/24", "198.51.100.0/24"]
+			  ip6  = ["2001:db8::/32"]
+			  all  = "-"
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_spf.test", "value", "v=spf1 ip4:192.0.2.0/24 ip4:198.51.100.0/24 ip6:2001:db8::/32 -all"),
+					resource.TestCheckResourceAttr("abion_dns_spf.test", "lookups", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -152,3 +152,68 @@ func TestAccDnsTXTRecordNonExistingZoneResource(t *testing.T) {
 		},
 	})
 }
+
+func TestAccDnsTXTRecordProviderDefaultsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing with provider default ttl and comments
+			{
+				Config: `
+			provider "abion" {
+			  default_ttl      = 3600
+			  default_comments = "managed by terraform"
+			}
+
+			resource "abion_dns_txt_record" "test" {
+			  zone  = "pmapitest1.com"
+			  name = "defaults"
+			  records = [
+				{
+				  txt_data = "txt data 1"
+				},
+				{
+				  txt_data = "txt data 2"
+				  ttl      = 300
+				  comments = "explicit comment"
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.#", "2"),
+
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.0.txt_data", "txt data 1"),
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.0.ttl", "3600"),
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.0.comments", "managed by terraform"),
+
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.1.txt_data", "txt data 2"),
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.1.ttl", "300"),
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.1.comments", "explicit comment"),
+				),
+			},
+			// Remove the provider defaults, ttl and comments are planned as unset
+			{
+				Config: providerConfig + `
+			resource "abion_dns_txt_record" "test" {
+			  zone  = "pmapitest1.com"
+			  name = "defaults"
+			  records = [
+				{
+				  txt_data = "txt data 1"
+				},
+			  ]
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_txt_record.test", "records.#", "1"),
+					resource.TestCheckNoResourceAttr("abion_dns_txt_record.test", "records.0.ttl"),
+					resource.TestCheckNoResourceAttr("abion_dns_txt_record.test", "records.0.comments"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,225 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	abionclient "terraform-provider-abion/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsZoneDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZoneDataSource{}
+)
+
+// NewDnsZoneDataSource is a helper function to simplify the provider implementation.
+func NewDnsZoneDataSource() datasource.DataSource {
+	return &dnsZoneDataSource{}
+}
+
+// dnsZoneDataSource is the data source implementation.
+type dnsZoneDataSource struct {
+	client *abionclient.Client
+}
+
+// dnsZoneModel maps the data source schema data.
+type dnsZoneModel struct {
+	Zone                    types.String           `tfsdk:"zone"`
+	OrganisationID          types.String           `tfsdk:"organisation_id"`
+	OrganisationDescription types.String           `tfsdk:"organisation_description"`
+	DNSTypeDescription      types.String           `tfsdk:"dns_type_description"`
+	Slave                   types.Bool             `tfsdk:"slave"`
+	Pending                 types.Bool             `tfsdk:"pending"`
+	Deleted                 types.Bool             `tfsdk:"deleted"`
+	Settings                *ZoneSettingsData      `tfsdk:"settings"`
+	RecordCounts            map[string]types.Int64 `tfsdk:"record_counts"`
+	Names                   []types.String         `tfsdk:"names"`
+}
+
+type ZoneSettingsData struct {
+	MName   types.String `tfsdk:"mname"`
+	Refresh types.Int32  `tfsdk:"refresh"`
+	Expire  types.Int32  `tfsdk:"expire"`
+	TTL     types.Int32  `tfsdk:"ttl"`
+}
+
+func (d *dnsZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsZoneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsZoneDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get the details of a zone, e.g. to verify in preconditions that a zone is not a slave zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the zone.",
+			},
+			"organisation_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the organisation owning the zone.",
+			},
+			"organisation_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the organisation owning the zone.",
+			},
+			"dns_type_description": schema.StringAttribute{
+				Computed:    true,
+				Description: "The description of the DNS service type of the zone.",
+			},
+			"slave": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the zone is a slave zone, i.e. transferred from another primary name server.",
+			},
+			"pending": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether changes to the zone are pending.",
+			},
+			"deleted": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the zone is deleted.",
+			},
+			"settings": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The SOA settings of the zone.",
+				Attributes: map[string]schema.Attribute{
+					"mname": schema.StringAttribute{
+						Computed:    true,
+						Description: "The host name of the primary name server of the zone (SOA MNAME).",
+					},
+					"refresh": schema.Int32Attribute{
+						Computed:    true,
+						Description: "The interval in seconds at which secondary name servers check the zone for changes (SOA REFRESH).",
+					},
+					"expire": schema.Int32Attribute{
+						Computed:    true,
+						Description: "The time in seconds after which secondary name servers stop answering for the zone if the primary name server can't be reached (SOA EXPIRE).",
+					},
+					"ttl": schema.Int32Attribute{
+						Computed:    true,
+						Description: "The time-to-live (TTL) of the zone, in seconds.",
+					},
+				},
+			},
+			"record_counts": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				MarkdownDescription: "The number of records in the zone by record type, e.g. `{ A = 3, MX = 2 }`.",
+			},
+			"names": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The sorted names that have records in the zone. The `@` character represents the root of the zone.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsZoneModel
+
+	// Load zone from the configuration into state
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Getting zone details")
+
+	// Get the zone details from Abion API
+	zone, err := d.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Zone from Abion API",
+			err.Error(),
+		)
+		return
+	}
+
+	attributes := zone.Data.Attributes
+
+	state.OrganisationID = types.StringValue(attributes.OrganisationID)
+	state.OrganisationDescription = types.StringValue(attributes.OrganisationDescription)
+	state.DNSTypeDescription = types.StringValue(attributes.DNSTypeDescription)
+	state.Slave = types.BoolValue(attributes.Slave)
+	state.Pending = types.BoolValue(attributes.Pending)
+	state.Deleted = types.BoolValue(attributes.Deleted)
+	state.Settings = toZoneSettingsData(attributes.Settings)
+	state.RecordCounts = make(map[string]types.Int64)
+	state.Names = []types.String{}
+
+	names := make([]string, 0, len(attributes.Records))
+	for name, recordTypes := range attributes.Records {
+		hasRecords := false
+		for recordType, records := range recordTypes {
+			if len(records) == 0 {
+				continue
+			}
+			hasRecords = true
+			count := state.RecordCounts[recordType].ValueInt64()
+			state.RecordCounts[recordType] = types.Int64Value(count + int64(len(records)))
+		}
+		if hasRecords {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+	for _, name := range names {
+		state.Names = append(state.Names, types.StringValue(name))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// toZoneSettingsData returns the settings of a zone as data source data, or nil if the zone has no settings.
+func toZoneSettingsData(settings *abionclient.Settings) *ZoneSettingsData {
+	if settings == nil {
+		return nil
+	}
+
+	return &ZoneSettingsData{
+		MName:   types.StringValue(settings.MName),
+		Refresh: types.Int32Value(int32(settings.Refresh)),
+		Expire:  types.Int32Value(int32(settings.Expire)),
+		TTL:     types.Int32Value(int32(settings.TTL)),
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create record and verify datasource
+			{
+				Config: providerConfig + `
+			resource "abion_dns_a_record" "test" {
+ 			  zone  = "pmapitest1.com"
+ 			  name  = "zonetest"
+			  records = [
+				{
+				  ip_address = "203.0.113.0"
+				},
+				{
+				  ip_address = "203.0.113.1"
+				},
+			  ]
+			}
+
+			data "abion_dns_zone" "test_data" {
+              zone  = abion_dns_a_record.test.zone
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.abion_dns_zone.test_data", "zone", "pmapitest1.com"),
+					resource.TestCheckResourceAttr("data.abion_dns_zone.test_data", "slave", "false"),
+					resource.TestCheckResourceAttr("data.abion_dns_zone.test_data", "deleted", "false"),
+					resource.TestCheckResourceAttrSet("data.abion_dns_zone.test_data", "organisation_id"),
+					resource.TestCheckResourceAttrSet("data.abion_dns_zone.test_data", "settings.ttl"),
+					resource.TestCheckTypeSetElemAttr("data.abion_dns_zone.test_data", "names.*", "zonetest"),
+					resource.TestCheckResourceAttrWith("data.abion_dns_zone.test_data", "record_counts.A", func(value string) error {
+						// The zone may contain other A records than the ones created by the test
+						if count, err := strconv.Atoi(value); err != nil || count < 2 {
+							return fmt.Errorf("expected at least 2 A records, got %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDnsZoneNonExistingZoneDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify error non existing zone
+			{
+				Config: providerConfig + `
+			data "abion_dns_zone" "non_existing" {
+              zone  = "non_existing.com"
+			}
+			`,
+				ExpectError: regexp.MustCompile("Unable to Read Zone from Abion API"),
+			},
+		},
+	})
+}
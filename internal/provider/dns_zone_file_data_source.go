@@ -0,0 +1,196 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/zonefile"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Placeholders of the exported zone files for the values that are not provided by the Abion API.
+const (
+	exportedSOASerial  = 1
+	exportedSOARefresh = 10800
+	exportedSOARetry   = 900
+	exportedSOAExpire  = 1209600
+	exportedTTL        = 3600
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsZoneFileDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZoneFileDataSource{}
+)
+
+// NewDnsZoneFileDataSource is a helper function to simplify the provider implementation.
+func NewDnsZoneFileDataSource() datasource.DataSource {
+	return &dnsZoneFileDataSource{}
+}
+
+// dnsZoneFileDataSource is the data source implementation.
+type dnsZoneFileDataSource struct {
+	client *abionclient.Client
+}
+
+// dnsZoneFileModel maps the data source schema data.
+type dnsZoneFileModel struct {
+	Zone    types.String `tfsdk:"zone"`
+	Content types.String `tfsdk:"content"`
+}
+
+func (d *dnsZoneFileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsZoneFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsZoneFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to export a zone as a zone file in the RFC 1035 master file format, " +
+			"e.g. for backups with `local_file` or for verification with `named-checkzone`.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The name of the zone.",
+			},
+			"content": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "The zone file. The `$TTL` and the SOA record are based on the zone settings. The SOA " +
+					"serial, retry and responsible mailbox are not provided by the Abion API, so placeholders are written " +
+					"and marked with a `;` comment, as are the `$TTL`, primary name server, refresh and expire if the " +
+					"zone settings leave them out. The records are sorted by " +
+					"name and type, and record comments are exported as `;` comments.",
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsZoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsZoneFileModel
+
+	// Load zone from the configuration into state
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Getting zone details")
+
+	// Get the zone details from Abion API
+	zone, err := d.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Zone from Abion API",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Content = types.StringValue(zonefile.Format(toZoneFile(state.Zone.ValueString(), zone.Data)))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// toZoneFile returns the zone as the content of a zone file.
+func toZoneFile(name string, zone *abionclient.Zone) zonefile.Zone {
+	origin := zonefile.Fqdn(name)
+	zoneFile := zonefile.Zone{Origin: origin}
+
+	// The $TTL is always written, so that records without a TTL have a default
+	ttl := exportedTTL
+	if settings := zone.Attributes.Settings; settings != nil && settings.TTL > 0 {
+		ttl = settings.TTL
+	} else {
+		zoneFile.Placeholders = append(zoneFile.Placeholders, "ttl")
+	}
+	zoneFile.TTL = &ttl
+
+	// The values the API doesn't provide are marked as placeholders
+	if settings := zone.Attributes.Settings; settings != nil {
+		zoneFile.SOA = &zonefile.SOA{
+			MName:   zonefile.Fqdn(settings.MName),
+			RName:   "hostmaster." + origin,
+			Serial:  exportedSOASerial,
+			Refresh: settings.Refresh,
+			Retry:   exportedSOARetry,
+			Expire:  settings.Expire,
+			Minimum: ttl,
+		}
+		zoneFile.Placeholders = append(zoneFile.Placeholders, "rname", "serial", "retry")
+
+		// The settings the API leaves out are replaced with placeholders too
+		if settings.MName == "" {
+			zoneFile.SOA.MName = "ns1." + origin
+			zoneFile.Placeholders = append(zoneFile.Placeholders, "mname")
+		}
+		if settings.Refresh == 0 {
+			zoneFile.SOA.Refresh = exportedSOARefresh
+			zoneFile.Placeholders = append(zoneFile.Placeholders, "refresh")
+		}
+		if settings.Expire == 0 {
+			zoneFile.SOA.Expire = exportedSOAExpire
+			zoneFile.Placeholders = append(zoneFile.Placeholders, "expire")
+		}
+	}
+
+	for name, recordTypes := range zone.Attributes.Records {
+		absoluteName := origin
+		if name != "@" {
+			absoluteName = name + "." + origin
+		}
+
+		for recordType, records := range recordTypes {
+			for _, record := range records {
+				comment := ""
+				if record.Comments != nil {
+					comment = *record.Comments
+				}
+
+				zoneFile.Records = append(zoneFile.Records, zonefile.Record{
+					Name:    absoluteName,
+					Type:    recordType,
+					TTL:     record.TTL,
+					Data:    record.Data,
+					Comment: comment,
+				})
+			}
+		}
+	}
+
+	return zoneFile
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+func TestAccDnsZoneFileDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create record and verify datasource
+			{
+				Config: providerConfig + `
+			resource "abion_dns_a_record" "test" {
+ 			  zone  = "pmapitest1.com"
+ 			  name  = "zonefiletest"
+			  records = [
+				{
+				  ip_address = "203.0.113.0"
+				  ttl        = 300
+				  comments   = "exported comment"
+				},
+			  ]
+			}
+
+			data "abion_dns_zone_file" "test_data" {
+              zone  = abion_dns_a_record.test.zone
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.abion_dns_zone_file.test_data", "zone", "pmapitest1.com"),
+					resource.TestMatchResourceAttr("data.abion_dns_zone_file.test_data", "content", regexp.MustCompile(`(?m)^\$ORIGIN pmapitest1\.com\.$`)),
+					resource.TestMatchResourceAttr("data.abion_dns_zone_file.test_data", "content", regexp.MustCompile(`(?m)^@\t\tIN\tSOA\t`)),
+					resource.TestMatchResourceAttr("data.abion_dns_zone_file.test_data", "content", regexp.MustCompile(`(?m)^zonefiletest\t300\tIN\tA\t203\.0\.113\.0\t; exported comment$`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestToZoneFilePartialSettings(t *testing.T) {
+	zone := &abionclient.Zone{Attributes: abionclient.Attributes{Settings: &abionclient.Settings{Refresh: 3600}}}
+
+	content := zonefile.Format(toZoneFile("example.com", zone))
+
+	for _, expected := range []string{
+		"$TTL 3600\t; placeholder\n",
+		"@\t\tIN\tSOA\tns1.example.com. hostmaster.example.com. (\t; mname and rname are placeholders\n",
+		"\t3600\t; refresh\n",
+		"\t1209600\t; expire, placeholder\n",
+	} {
+		if !strings.Contains(content, expected) {
+			t.Errorf("expected %q in the zone file:\n%s", expected, content)
+		}
+	}
+
+	if _, err := zonefile.Parse(content, "example.com"); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,536 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"sort"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneFileResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneFileResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneFileResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneFileResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneFileResource{}
+)
+
+// NewDnsZoneFileResource is a helper function to simplify the provider implementation.
+func NewDnsZoneFileResource() resource.Resource {
+	return &dnsZoneFileResource{}
+}
+
+// dnsZoneFileResource is the resource implementation.
+type dnsZoneFileResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsZoneFileResourceModel maps the resource schema data.
+type dnsZoneFileResourceModel struct {
+	Zone               types.String         `tfsdk:"zone"`
+	Content            types.String         `tfsdk:"content"`
+	Protected          []types.String       `tfsdk:"protected"`
+	Records            []ZoneFileRecordData `tfsdk:"records"`
+	WaitForConsistency types.Bool           `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value       `tfsdk:"timeouts"`
+}
+
+type ZoneFileRecordData struct {
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	RData    types.String `tfsdk:"rdata"`
+	TTL      types.Int32  `tfsdk:"ttl"`
+	Comments types.String `tfsdk:"comments"`
+}
+
+func (r *dnsZoneFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_file"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsZoneFileResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage all records of a zone authoritatively from a zone file in the " +
+			"RFC 1035 master file format. Records of the zone that are not in the file are deleted, unless they match " +
+			"one of the `protected` patterns. Only the A, AAAA, CAA, CNAME, MX, NS, PTR, SRV and TXT record types are " +
+			"managed, records of other types are left untouched. The SOA record of the file is ignored, use " +
+			"`abion_dns_zone_settings` to manage the zone settings. Destroying the resource leaves the records in the zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone to manage the records of. Relative names of the zone file are relative to the zone, until changed by an $ORIGIN directive.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required: true,
+				MarkdownDescription: "The content of the zone file, e.g. read with the `file` function. The `$ORIGIN` and " +
+					"`$TTL` directives, relative names, parentheses spanning several lines and `;` comments are " +
+					"supported. Records without a TTL use the `$TTL` of the file, or the `default_ttl` of the provider.",
+			},
+			"protected": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Records that are never managed by the resource, as `name/type` glob patterns, e.g. " +
+					"`@/NS`, `_acme-challenge*/TXT` or `legacy/*`. Protected records of the zone file are ignored, and " +
+					"protected records of the zone are never deleted. Defaults to `[\"@/NS\"]`.",
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("@/NS"),
+				})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(protectedRecordRegexp, "must be in the format name/type"),
+						globPatternValidator{},
+					),
+				},
+			},
+			"records": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "The records of the zone file that are managed by the resource.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the record, relative to the zone. The `@` character represents the root of the zone.",
+						},
+						"type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The record type.",
+						},
+						"rdata": schema.StringAttribute{
+							Computed:    true,
+							Description: "The record data in the presentation format of the record type.",
+						},
+						"ttl": schema.Int32Attribute{
+							Computed:    true,
+							Description: "Time-to-live (TTL) for the record, in seconds.",
+						},
+						"comments": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Comments for the record, the `default_comments` of the provider.",
+						},
+					},
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that the zone file can be parsed.
+func (r *dnsZoneFileResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var zone, content types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("content"), &content)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Unknown values are verified when the resource is planned
+	if zone.IsNull() || zone.IsUnknown() || content.IsNull() || content.IsUnknown() {
+		return
+	}
+
+	_, diags := zoneFileRecords(nil, zone.ValueString(), content.ValueString(), nil)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan plans the records of the zone file and validates the planned changes against the allowed_zones and
+// read_only settings of the provider.
+func (r *dnsZoneFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var zone, content types.String
+		var protected types.List
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("content"), &content)...)
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("protected"), &protected)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The records stay unknown until the zone file is known
+		if !zone.IsUnknown() && !content.IsUnknown() && !protected.IsUnknown() {
+			var patterns []types.String
+			resp.Diagnostics.Append(protected.ElementsAs(ctx, &patterns, false)...)
+
+			records, diags := zoneFileRecords(r.providerData, zone.ValueString(), content.ValueString(), patterns)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("records"), records)...)
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create takes ownership of the records of the zone and sets the initial Terraform state.
+func (r *dnsZoneFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsZoneFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// An imported resource uses the default protected records
+	if state.Protected == nil {
+		state.Protected = []types.String{types.StringValue("@/NS")}
+	}
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Records = []ZoneFileRecordData{}
+	for name, recordTypes := range zone.Data.Attributes.Records {
+		for recordType, records := range recordTypes {
+			if !zoneRecordTypeManaged(recordType) || recordProtected(state.Protected, name, recordType) {
+				continue
+			}
+
+			for _, record := range records {
+				state.Records = append(state.Records, ZoneFileRecordData{
+					Name:     types.StringValue(name),
+					Type:     types.StringValue(recordType),
+					RData:    types.StringValue(record.Data),
+					TTL:      utils.IntPointerToInt32(record.TTL),
+					Comments: utils.StringPointerToTerraformString(record.Comments),
+				})
+			}
+		}
+	}
+	sortZoneFileRecords(state.Records)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneFileResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the Terraform state. The records are left in the zone, as deleting every record of a zone is
+// rarely intended.
+func (r *dnsZoneFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsZoneFileResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Releasing zone file records, the records are left in the zone")
+}
+
+// ImportState imports the records of the zone given by the import ID.
+func (r *dnsZoneFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+}
+
+// apply patches the zone so that its managed records are exactly the planned records.
+func (r *dnsZoneFileResource) apply(ctx context.Context, plan dnsZoneFileResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, plan.Zone.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return diags
+	}
+
+	patchRequest := abionclient.DiffZones(*zone.Data, r.desiredZone(*zone.Data, plan))
+	if abionclient.IsEmptyPatch(patchRequest) {
+		tflog.Debug(ctx, "Zone records are up to date")
+		return diags
+	}
+
+	tflog.Debug(ctx, "Patching zone records")
+
+	_, err = r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not update zone file records, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the records to become visible in the zone
+	diags.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+
+	return diags
+}
+
+// desiredZone returns the zone with its managed records replaced by the planned records. The records of a name and
+// type are kept in the order of the zone, as the records are planned as a set.
+func (r *dnsZoneFileResource) desiredZone(zone abionclient.Zone, plan dnsZoneFileResourceModel) abionclient.Zone {
+	records := make(map[string]map[string][]abionclient.Record)
+
+	for _, record := range plan.Records {
+		name := record.Name.ValueString()
+		recordType := record.Type.ValueString()
+
+		if records[name] == nil {
+			records[name] = make(map[string][]abionclient.Record)
+		}
+		records[name][recordType] = append(records[name][recordType], abionclient.Record{
+			Data:     record.RData.ValueString(),
+			TTL:      utils.Int32ToIntPointer(record.TTL),
+			Comments: record.Comments.ValueStringPointer(),
+		})
+	}
+
+	for name, recordTypes := range records {
+		for recordType, data := range recordTypes {
+			current := zone.Attributes.Records[name][recordType]
+			sort.SliceStable(data, func(i, j int) bool {
+				return recordIndex(current, data[i].Data) < recordIndex(current, data[j].Data)
+			})
+		}
+	}
+
+	return replaceManagedRecords(zone, plan.Protected, records)
+}
+
+// recordIndex returns the index of the record with the rdata in the records, or the number of records if there is
+// none, so that new records are sorted last.
+func recordIndex(records []abionclient.Record, rdata string) int {
+	if i := findRecordMember(records, rdata); i >= 0 {
+		return i
+	}
+	return len(records)
+}
+
+// zoneFileRecords parses the zone file content into the records managed by the resource. The SOA record and
+// protected records are skipped, and the provider defaults are planned for records without ttl.
+func zoneFileRecords(providerData *AbionProviderData, zone string, content string, protected []types.String) ([]ZoneFileRecordData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	addError := func(line int, message string) {
+		diags.AddAttributeError(
+			path.Root("content"),
+			"Invalid zone file",
+			fmt.Sprintf("line %d: %s", line, message),
+		)
+	}
+
+	parsed, err := zonefile.Parse(content, zone)
+	if err != nil {
+		var parseErr *zonefile.Error
+		if errors.As(err, &parseErr) {
+			addError(parseErr.Line, parseErr.Message)
+		} else {
+			diags.AddAttributeError(path.Root("content"), "Invalid zone file", err.Error())
+		}
+		return nil, diags
+	}
+
+	defaultTTL := types.Int32Null()
+	defaultComments := types.StringNull()
+	if providerData != nil {
+		defaultTTL = providerData.DefaultTTL
+		defaultComments = providerData.DefaultComments
+	}
+
+	records := []ZoneFileRecordData{}
+	seen := make(map[string]int)
+
+	for _, record := range parsed {
+		if record.Type == "SOA" {
+			continue
+		}
+
+		name, ok := zonefile.RelativeName(record.Name, zone)
+		if !ok {
+			addError(record.Line, "the name "+record.Name+" is not within the zone "+zone)
+			continue
+		}
+
+		if recordProtected(protected, name, record.Type) {
+			continue
+		}
+
+		key := name + " " + record.Type + " " + record.Data
+		if line, found := seen[key]; found {
+			addError(record.Line, fmt.Sprintf("duplicate %s record %s of %s, already declared on line %d", record.Type, record.Data, name, line))
+			continue
+		}
+		seen[key] = record.Line
+
+		ttl := utils.IntPointerToInt32(record.TTL)
+		if ttl.IsNull() {
+			ttl = defaultTTL
+		}
+
+		records = append(records, ZoneFileRecordData{
+			Name:     types.StringValue(name),
+			Type:     types.StringValue(record.Type),
+			RData:    types.StringValue(record.Data),
+			TTL:      ttl,
+			Comments: defaultComments,
+		})
+	}
+	sortZoneFileRecords(records)
+
+	return records, diags
+}
+
+// sortZoneFileRecords sorts the records by name, type and rdata.
+func sortZoneFileRecords(records []ZoneFileRecordData) {
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.Name.ValueString() != b.Name.ValueString() {
+			return a.Name.ValueString() < b.Name.ValueString()
+		}
+		if a.Type.ValueString() != b.Type.ValueString() {
+			return a.Type.ValueString() < b.Type.ValueString()
+		}
+		return a.RData.ValueString() < b.RData.ValueString()
+	})
+}
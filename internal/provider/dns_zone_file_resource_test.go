@@ -0,0 +1,110 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsZoneFileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify that parse errors are reported with the line number
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_file" "test" {
+			  zone    = "pmapitest4.com"
+			  content = <<-EOT
+				www  IN A 192.0.2.1
+				mail IN MX mx1.pmapitest4.com.
+			  EOT
+			}
+			`,
+				ExpectError: regexp.MustCompile("line 2: invalid MX record"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_file" "test" {
+			  zone    = "pmapitest4.com"
+			  content = <<-EOT
+				$TTL 1h
+				@     IN SOA ns1.abion.com. hostmaster (
+				            2024010101 3h 1h 2w 1h )
+				      IN NS  ns1.abion.com.
+				www   300 IN A 192.0.2.1
+				            IN A 192.0.2.2 ; second web server
+				mail  IN MX 10 mx1
+				@     IN TXT "v=spf1 -all"
+			  EOT
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_file.test", "records.#", "4"),
+					resource.TestCheckTypeSetElemNestedAttrs("abion_dns_zone_file.test", "records.*", map[string]string{
+						"name":  "www",
+						"type":  "A",
+						"rdata": "192.0.2.1",
+						"ttl":   "300",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("abion_dns_zone_file.test", "records.*", map[string]string{
+						"name":  "www",
+						"type":  "A",
+						"rdata": "192.0.2.2",
+						"ttl":   "3600",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("abion_dns_zone_file.test", "records.*", map[string]string{
+						"name":  "mail",
+						"type":  "MX",
+						"rdata": "10 mx1.pmapitest4.com.",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("abion_dns_zone_file.test", "records.*", map[string]string{
+						"name":  "@",
+						"type":  "TXT",
+						"rdata": "v=spf1 -all",
+					}),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_file" "test" {
+			  zone    = "pmapitest4.com"
+			  content = <<-EOT
+				$ORIGIN pmapitest4.com.
+				www   600 IN A 192.0.2.1
+				@     IN TXT "v=spf1 -all"
+			  EOT
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_file.test", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("abion_dns_zone_file.test", "records.*", map[string]string{
+						"name":  "www",
+						"type":  "A",
+						"rdata": "192.0.2.1",
+						"ttl":   "600",
+					}),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_zone_file.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest4.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+				// The zone file content can't be read from the API
+				ImportStateVerifyIgnore: []string{"content"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
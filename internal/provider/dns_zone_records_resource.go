@@ -0,0 +1,578 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	pathpkg "path"
+	"regexp"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// zoneRecordTypes are the record types managed by the abion_dns_zone_records resource, in schema order.
+var zoneRecordTypes = []utils.RecordType{
+	utils.RecordTypeA,
+	utils.RecordTypeAAAA,
+	utils.RecordTypeCAA,
+	utils.RecordTypeCName,
+	utils.RecordTypeMX,
+	utils.RecordTypeNS,
+	utils.RecordTypePTR,
+	utils.RecordTypeSRV,
+	utils.RecordTypeTXT,
+}
+
+// protectedRecordRegexp matches a protected record pattern in the format "name/type".
+var protectedRecordRegexp = regexp.MustCompile(`^[^/]+/[^/]+$`)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithConfigure      = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithImportState    = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithModifyPlan     = &dnsZoneRecordsResource{}
+	_ resource.ResourceWithValidateConfig = &dnsZoneRecordsResource{}
+)
+
+// NewDnsZoneRecordsResource is a helper function to simplify the provider implementation.
+func NewDnsZoneRecordsResource() resource.Resource {
+	return &dnsZoneRecordsResource{}
+}
+
+// dnsZoneRecordsResource is the resource implementation.
+type dnsZoneRecordsResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsZoneRecordsResourceModel maps the resource schema data.
+type dnsZoneRecordsResourceModel struct {
+	Zone               types.String                  `tfsdk:"zone"`
+	Records            map[string]ZoneRecordSetsData `tfsdk:"records"`
+	Protected          []types.String                `tfsdk:"protected"`
+	WaitForConsistency types.Bool                    `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value                `tfsdk:"timeouts"`
+}
+
+type ZoneRecordSetsData struct {
+	A     []RecordData `tfsdk:"a"`
+	AAAA  []RecordData `tfsdk:"aaaa"`
+	CAA   []RecordData `tfsdk:"caa"`
+	CName []RecordData `tfsdk:"cname"`
+	MX    []RecordData `tfsdk:"mx"`
+	NS    []RecordData `tfsdk:"ns"`
+	PTR   []RecordData `tfsdk:"ptr"`
+	SRV   []RecordData `tfsdk:"srv"`
+	TXT   []RecordData `tfsdk:"txt"`
+}
+
+// recordSets returns the record lists of the name by record type.
+func (d *ZoneRecordSetsData) recordSets() map[utils.RecordType]*[]RecordData {
+	return map[utils.RecordType]*[]RecordData{
+		utils.RecordTypeA:     &d.A,
+		utils.RecordTypeAAAA:  &d.AAAA,
+		utils.RecordTypeCAA:   &d.CAA,
+		utils.RecordTypeCName: &d.CName,
+		utils.RecordTypeMX:    &d.MX,
+		utils.RecordTypeNS:    &d.NS,
+		utils.RecordTypePTR:   &d.PTR,
+		utils.RecordTypeSRV:   &d.SRV,
+		utils.RecordTypeTXT:   &d.TXT,
+	}
+}
+
+func (r *dnsZoneRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneRecordsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_records"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsZoneRecordsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	recordSetAttributes := make(map[string]schema.Attribute)
+	for _, recordType := range zoneRecordTypes {
+		recordSetAttributes[strings.ToLower(recordType.String())] = schema.ListNestedAttribute{
+			Optional:    true,
+			Description: "The list of " + recordType.String() + " records of the name.",
+			Validators: []validator.List{
+				listvalidator.SizeAtLeast(1),
+			},
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"rdata": schema.StringAttribute{
+						Required:    true,
+						Description: "The record data in the presentation format of the record type.",
+					},
+					"ttl": schema.Int32Attribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Time-to-live (TTL) for the record, in seconds. Defaults to the `default_ttl` of the provider.",
+					},
+					"comments": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						MarkdownDescription: "Comments for the record. Defaults to the `default_comments` of the provider.",
+					},
+				},
+			},
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage all records of a zone authoritatively. Records of the zone " +
+			"that are not declared are deleted, unless they match one of the `protected` patterns. Only the record types " +
+			"of the schema are managed, records of other types are left untouched. Destroying the resource leaves the " +
+			"records in the zone.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone to manage the records of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.MapNestedAttribute{
+				Required: true,
+				MarkdownDescription: "The records of the zone by name and record type. The names are for example `@`, " +
+					"`www`, `ftp`, `www.east`. The `@` character represents the root of the zone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: recordSetAttributes,
+				},
+			},
+			"protected": schema.ListAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				MarkdownDescription: "Records that are never managed by the resource, as `name/type` glob patterns, e.g. " +
+					"`@/NS`, `_acme-challenge*/TXT` or `legacy/*`. Protected records can't be declared in `records` and " +
+					"are never deleted. Defaults to `[\"@/NS\"]`.",
+				Default: listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("@/NS"),
+				})),
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(protectedRecordRegexp, "must be in the format name/type"),
+						globPatternValidator{},
+					),
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the records to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ValidateConfig verifies that every name declares records and that no protected records are declared.
+func (r *dnsZoneRecordsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	// Unknown values are verified when the resource is applied
+	if !req.Config.Raw.IsFullyKnown() {
+		return
+	}
+
+	var config dnsZoneRecordsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Validate against the default when protected is not configured
+	if config.Protected == nil {
+		config.Protected = []types.String{types.StringValue("@/NS")}
+	}
+
+	resp.Diagnostics.Append(validateRecordSetsDeclared(config)...)
+	resp.Diagnostics.Append(validateNoProtectedRecords(config)...)
+}
+
+// ModifyPlan plans the record defaults and validates the planned changes against the allowed_zones and read_only
+// settings of the provider.
+func (r *dnsZoneRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() {
+		var records types.Map
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("records"), &records)...)
+		if !resp.Diagnostics.HasError() && !records.IsNull() && !records.IsUnknown() {
+			for name := range records.Elements() {
+				for _, recordType := range zoneRecordTypes {
+					recordsPath := path.Root("records").AtMapKey(name).AtName(strings.ToLower(recordType.String()))
+					modifyPlanRecordDefaults(ctx, r.providerData, recordsPath, req, resp)
+				}
+			}
+		}
+	}
+
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create takes ownership of the records of the zone and sets the initial Terraform state.
+func (r *dnsZoneRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneRecordsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsZoneRecordsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// An imported resource uses the default protected records
+	if state.Protected == nil {
+		state.Protected = []types.String{types.StringValue("@/NS")}
+	}
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Records = make(map[string]ZoneRecordSetsData)
+	for name, recordTypes := range zone.Data.Attributes.Records {
+		var recordSetsData ZoneRecordSetsData
+		found := false
+
+		for recordType, recordSet := range recordSetsData.recordSets() {
+			records := recordTypes[recordType.String()]
+			if len(records) == 0 || recordProtected(state.Protected, name, recordType.String()) {
+				continue
+			}
+
+			for _, record := range records {
+				*recordSet = append(*recordSet, RecordData{
+					RData:    types.StringValue(record.Data),
+					Comments: utils.StringPointerToTerraformString(record.Comments),
+					TTL:      utils.IntPointerToInt32(record.TTL),
+				})
+			}
+			found = true
+		}
+
+		if found {
+			state.Records[name] = recordSetsData
+		}
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneRecordsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.apply(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the Terraform state. The records are left in the zone, as deleting every record of a zone is
+// rarely intended.
+func (r *dnsZoneRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnsZoneRecordsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Releasing zone records, the records are left in the zone")
+}
+
+// ImportState imports the records of the zone given by the import ID.
+func (r *dnsZoneRecordsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+}
+
+// apply patches the zone so that its managed records are exactly the planned records.
+func (r *dnsZoneRecordsResource) apply(ctx context.Context, plan dnsZoneRecordsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Verify that the provider allows changes to the zone
+	diags.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	diags.Append(validateNoProtectedRecords(plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, plan.Zone.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return diags
+	}
+
+	patchRequest := abionclient.DiffZones(*zone.Data, r.desiredZone(*zone.Data, plan))
+	if abionclient.IsEmptyPatch(patchRequest) {
+		tflog.Debug(ctx, "Zone records are up to date")
+		return diags
+	}
+
+	tflog.Debug(ctx, "Patching zone records")
+
+	_, err = r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		diags.AddError(
+			"Error patching zone",
+			"Could not update zone records, unexpected error: "+err.Error(),
+		)
+		return diags
+	}
+
+	// Wait for the records to become visible in the zone
+	diags.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+
+	return diags
+}
+
+// desiredZone returns the zone with its managed records replaced by the planned records.
+func (r *dnsZoneRecordsResource) desiredZone(zone abionclient.Zone, plan dnsZoneRecordsResourceModel) abionclient.Zone {
+	records := make(map[string]map[string][]abionclient.Record)
+
+	for name, recordSetsData := range plan.Records {
+		for recordType, recordSet := range recordSetsData.recordSets() {
+			if len(*recordSet) == 0 {
+				continue
+			}
+
+			var data []abionclient.Record
+			for _, record := range *recordSet {
+				data = append(data, abionclient.Record{
+					Data:     record.RData.ValueString(),
+					TTL:      utils.Int32ToIntPointer(record.TTL),
+					Comments: record.Comments.ValueStringPointer(),
+				})
+			}
+
+			if records[name] == nil {
+				records[name] = make(map[string][]abionclient.Record)
+			}
+			records[name][recordType.String()] = data
+		}
+	}
+
+	return replaceManagedRecords(zone, plan.Protected, records)
+}
+
+// replaceManagedRecords returns the zone with its managed records replaced by the records. Protected records and
+// records of types that are not in zoneRecordTypes are kept.
+func replaceManagedRecords(zone abionclient.Zone, protected []types.String, records map[string]map[string][]abionclient.Record) abionclient.Zone {
+	desired := make(map[string]map[string][]abionclient.Record)
+
+	for name, recordTypes := range zone.Attributes.Records {
+		for recordType, data := range recordTypes {
+			if zoneRecordTypeManaged(recordType) && !recordProtected(protected, name, recordType) {
+				continue
+			}
+			if desired[name] == nil {
+				desired[name] = make(map[string][]abionclient.Record)
+			}
+			desired[name][recordType] = data
+		}
+	}
+
+	for name, recordTypes := range records {
+		for recordType, data := range recordTypes {
+			if desired[name] == nil {
+				desired[name] = make(map[string][]abionclient.Record)
+			}
+			desired[name][recordType] = data
+		}
+	}
+
+	// Settings are left untouched by the patch
+	zone.Attributes.Settings = nil
+	zone.Attributes.Records = desired
+	return zone
+}
+
+// zoneRecordTypeManaged returns true if the record type is one of zoneRecordTypes.
+func zoneRecordTypeManaged(recordType string) bool {
+	for _, managed := range zoneRecordTypes {
+		if managed.String() == recordType {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRecordSetsDeclared verifies that every name of the records declares records of at least one record type, as
+// a name without records is never read back from the zone.
+func validateRecordSetsDeclared(model dnsZoneRecordsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, recordSetsData := range model.Records {
+		declared := false
+		for _, recordSet := range recordSetsData.recordSets() {
+			if len(*recordSet) > 0 {
+				declared = true
+				break
+			}
+		}
+
+		if !declared {
+			diags.AddAttributeError(
+				path.Root("records").AtMapKey(name),
+				"Missing records",
+				"The name "+name+" declares no records. Declare records of at least one record type, or remove the name.",
+			)
+		}
+	}
+
+	return diags
+}
+
+// validateNoProtectedRecords verifies that none of the declared records are protected.
+func validateNoProtectedRecords(model dnsZoneRecordsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for name, recordSetsData := range model.Records {
+		for recordType, recordSet := range recordSetsData.recordSets() {
+			if len(*recordSet) > 0 && recordProtected(model.Protected, name, recordType.String()) {
+				diags.AddAttributeError(
+					path.Root("records").AtMapKey(name).AtName(strings.ToLower(recordType.String())),
+					"Protected records declared",
+					"The "+recordType.String()+" records of "+name+" match one of the protected patterns and can't "+
+						"be managed. Remove the records, or the pattern from protected.",
+				)
+			}
+		}
+	}
+
+	return diags
+}
+
+// recordProtected returns true if the records of the name and type match one of the protected "name/type" patterns.
+// Names are matched case-insensitively, types are matched in upper case.
+func recordProtected(protected []types.String, name string, recordType string) bool {
+	for _, pattern := range protected {
+		namePattern, typePattern, found := strings.Cut(pattern.ValueString(), "/")
+		if !found {
+			continue
+		}
+
+		nameMatched, _ := pathpkg.Match(strings.ToLower(namePattern), strings.ToLower(name))
+		typeMatched, _ := pathpkg.Match(strings.ToUpper(typePattern), strings.ToUpper(recordType))
+		if nameMatched && typeMatched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,134 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRecordProtected(t *testing.T) {
+	protected := []types.String{
+		types.StringValue("@/NS"),
+		types.StringValue("_acme-challenge*/TXT"),
+		types.StringValue("legacy/*"),
+	}
+
+	tests := []struct {
+		name       string
+		recordType string
+		expected   bool
+	}{
+		{name: "@", recordType: "NS", expected: true},
+		{name: "@", recordType: "MX", expected: false},
+		{name: "www", recordType: "NS", expected: false},
+		{name: "_acme-challenge", recordType: "TXT", expected: true},
+		{name: "_acme-challenge.www", recordType: "TXT", expected: true},
+		{name: "_acme-challenge", recordType: "CNAME", expected: false},
+		{name: "LEGACY", recordType: "A", expected: true},
+		{name: "legacy", recordType: "txt", expected: true},
+	}
+
+	for _, test := range tests {
+		if actual := recordProtected(protected, test.name, test.recordType); actual != test.expected {
+			t.Errorf("recordProtected(%s, %s): expected %t, got %t", test.name, test.recordType, test.expected, actual)
+		}
+	}
+}
+
+func TestAccDnsZoneRecordsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify that protected records can't be declared
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_records" "test" {
+			  zone = "pmapitest4.com"
+			  records = {
+				"@" = {
+				  ns = [{ rdata = "ns1.test.com." }]
+				}
+			  }
+			}
+			`,
+				ExpectError: regexp.MustCompile("Protected records declared"),
+			},
+			// Verify that names without records can't be declared
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_records" "test" {
+			  zone = "pmapitest4.com"
+			  records = {
+				"www" = {}
+			  }
+			}
+			`,
+				ExpectError: regexp.MustCompile("Missing records"),
+			},
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_records" "test" {
+			  zone = "pmapitest4.com"
+			  records = {
+				"@" = {
+				  mx = [
+					{ rdata = "10 mx1.test.com." },
+					{ rdata = "20 mx2.test.com.", ttl = 3600 },
+				  ]
+				}
+				"www" = {
+				  a   = [{ rdata = "203.0.113.0" }]
+				  txt = [{ rdata = "test", comments = "test comment" }]
+				}
+			  }
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "zone", "pmapitest4.com"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "protected.#", "1"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "protected.0", "@/NS"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.%", "2"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.@.mx.#", "2"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.@.mx.1.ttl", "3600"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.www.a.0.rdata", "203.0.113.0"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.www.txt.0.comments", "test comment"),
+				),
+			},
+			// Update and Read testing, records that are no longer declared are deleted
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_records" "test" {
+			  zone = "pmapitest4.com"
+			  records = {
+				"www" = {
+				  a = [{ rdata = "203.0.113.1" }]
+				}
+			  }
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.%", "1"),
+					resource.TestCheckResourceAttr("abion_dns_zone_records.test", "records.www.a.0.rdata", "203.0.113.1"),
+					resource.TestCheckNoResourceAttr("abion_dns_zone_records.test", "records.www.txt"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         "abion_dns_zone_records.test",
+				ImportState:                          true,
+				ImportStateId:                        "pmapitest4.com",
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "zone",
+			},
+			// Delete testing automatically occurs in TestCase, the records are left in the zone
+		},
+	})
+}
@@ -0,0 +1,458 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+// originalSettingsKey is the private state key of the zone settings found before the resource took ownership.
+const originalSettingsKey = "original_settings"
+
+// Ranges of the zone settings recommended by RFC 1912 and RFC 2308, in seconds.
+const (
+	minSettingsRefresh = 1200
+	maxSettingsRefresh = 43200
+	minSettingsExpire  = 1209600
+	maxSettingsExpire  = 2419200
+	minSettingsTTL     = 3600
+	maxSettingsTTL     = 86400
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &dnsZoneSettingsResource{}
+	_ resource.ResourceWithConfigure   = &dnsZoneSettingsResource{}
+	_ resource.ResourceWithImportState = &dnsZoneSettingsResource{}
+	_ resource.ResourceWithModifyPlan  = &dnsZoneSettingsResource{}
+)
+
+// NewDnsZoneSettingsResource is a helper function to simplify the provider implementation.
+func NewDnsZoneSettingsResource() resource.Resource {
+	return &dnsZoneSettingsResource{}
+}
+
+// dnsZoneSettingsResource is the resource implementation.
+type dnsZoneSettingsResource struct {
+	client       *abionclient.Client
+	providerData *AbionProviderData
+}
+
+// dnsZoneSettingsResourceModel maps the resource schema data.
+type dnsZoneSettingsResourceModel struct {
+	Zone               types.String   `tfsdk:"zone"`
+	MName              types.String   `tfsdk:"mname"`
+	Refresh            types.Int32    `tfsdk:"refresh"`
+	Expire             types.Int32    `tfsdk:"expire"`
+	TTL                types.Int32    `tfsdk:"ttl"`
+	WaitForConsistency types.Bool     `tfsdk:"wait_for_consistency"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *dnsZoneSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.Client
+	r.providerData = providerData
+}
+
+// Metadata returns the resource type name.
+func (r *dnsZoneSettingsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone_settings"
+}
+
+// Schema defines the schema for the resource.
+func (r *dnsZoneSettingsResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this resource to manage the SOA settings of a zone. Settings that are not configured " +
+			"keep their current value. When the resource is destroyed the settings are restored to the values found " +
+			"when the resource was created or imported.",
+		Attributes: map[string]schema.Attribute{
+			"zone": schema.StringAttribute{
+				Required:    true,
+				Description: "The zone to manage the settings of.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mname": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The host name of the primary name server of the zone (SOA MNAME).",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(hostnameRegexp, "must be a fully qualified host name"),
+				},
+			},
+			"refresh": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The interval in seconds at which secondary name servers check the zone "+
+					"for changes (SOA REFRESH). Must be between `%d` and `%d`.", minSettingsRefresh, maxSettingsRefresh),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(minSettingsRefresh, maxSettingsRefresh),
+				},
+			},
+			"expire": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The time in seconds after which secondary name servers stop answering "+
+					"for the zone if the primary name server can't be reached (SOA EXPIRE). Must be between `%d` and `%d`.",
+					minSettingsExpire, maxSettingsExpire),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(minSettingsExpire, maxSettingsExpire),
+				},
+			},
+			"ttl": schema.Int32Attribute{
+				Optional: true,
+				Computed: true,
+				MarkdownDescription: fmt.Sprintf("The time-to-live (TTL) of the zone, in seconds. Must be between `%d` "+
+					"and `%d`.", minSettingsTTL, maxSettingsTTL),
+				PlanModifiers: []planmodifier.Int32{
+					int32planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int32{
+					int32validator.Between(minSettingsTTL, maxSettingsTTL),
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				Optional: true,
+				MarkdownDescription: "Wait for the settings to become visible in the zone before finishing create and update. " +
+					"Overrides the `wait_for_consistency` setting of the provider.",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan validates the planned changes against the allowed_zones and read_only settings of the provider.
+func (r *dnsZoneSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	modifyPlanGuards(ctx, r.providerData, req, resp)
+}
+
+// Create takes ownership of the zone settings and sets the initial Terraform state.
+func (r *dnsZoneSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultCreateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the current settings, they are restored when the resource is destroyed
+	zone, err := r.client.GetZone(ctx, plan.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	original, err := json.Marshal(zoneSettings(zone.Data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving zone settings",
+			"Could not save the current zone settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	patchRequest := abionclient.CreateSettingsPatchRequest(plan.Zone.ValueString(), r.plannedSettings(plan))
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	tflog.Debug(ctx, "Creating zone settings")
+
+	_, err = r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not create zone settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, originalSettingsKey, original)...)
+
+	// Settings that are not configured keep their current value
+	patched := abionclient.ApplyZonePatch(*zone.Data, patchRequest)
+	settingsToModel(zoneSettings(&patched), &plan)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the settings to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dnsZoneSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var state dnsZoneSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// Get the zone details from Abion API
+	zone, err := r.client.GetZone(ctx, state.Zone.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	settingsToModel(zoneSettings(zone.Data), &state)
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dnsZoneSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan dnsZoneSettingsResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultUpdateTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, plan.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	patchRequest := abionclient.CreateSettingsPatchRequest(plan.Zone.ValueString(), r.plannedSettings(plan))
+
+	ctx = tflog.SetField(ctx, "zone", plan.Zone.ValueString())
+	tflog.Debug(ctx, "Updating zone settings")
+
+	_, err := r.client.PatchZone(ctx, plan.Zone.ValueString(), patchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not update zone settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Wait for the settings to become visible in the zone
+	resp.Diagnostics.Append(waitForConsistency(ctx, r.providerData, plan.WaitForConsistency, plan.Zone.ValueString(), patchRequest)...)
+}
+
+// Delete restores the zone settings found before the resource took ownership and removes the Terraform state on
+// success.
+func (r *dnsZoneSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state dnsZoneSettingsResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultDeleteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	// Verify that the provider allows changes to the zone
+	resp.Diagnostics.Append(checkZoneWritable(r.providerData, state.Zone.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	original, diags := req.Private.GetKey(ctx, originalSettingsKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var settings abionclient.Settings
+	if original == nil || json.Unmarshal(original, &settings) != nil || settings == (abionclient.Settings{}) {
+		resp.Diagnostics.AddWarning(
+			"Zone settings not restored",
+			"The settings of the zone "+state.Zone.ValueString()+" found before the resource took ownership are "+
+				"unknown, the current settings are kept.",
+		)
+		return
+	}
+
+	patchRequest := abionclient.CreateSettingsPatchRequest(state.Zone.ValueString(), settings)
+
+	ctx = tflog.SetField(ctx, "zone", state.Zone.ValueString())
+	tflog.Debug(ctx, "Restoring zone settings")
+
+	_, err := r.client.PatchZone(ctx, state.Zone.ValueString(), patchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error patching zone",
+			"Could not restore zone settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState imports the settings of the zone given by the import ID and saves them to be restored when the
+// resource is destroyed.
+func (r *dnsZoneSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	zone, err := r.client.GetZone(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Abion Zone",
+			err.Error(),
+		)
+		return
+	}
+
+	original, err := json.Marshal(zoneSettings(zone.Data))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error saving zone settings",
+			"Could not save the current zone settings, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, originalSettingsKey, original)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zone"), req.ID)...)
+}
+
+// plannedSettings returns the settings of the plan. Settings that are not configured are left empty, so that they
+// are omitted from the patch and keep their current value.
+func (r *dnsZoneSettingsResource) plannedSettings(plan dnsZoneSettingsResourceModel) abionclient.Settings {
+	var settings abionclient.Settings
+
+	if !plan.MName.IsUnknown() {
+		settings.MName = plan.MName.ValueString()
+	}
+	if !plan.Refresh.IsUnknown() {
+		settings.Refresh = int(plan.Refresh.ValueInt32())
+	}
+	if !plan.Expire.IsUnknown() {
+		settings.Expire = int(plan.Expire.ValueInt32())
+	}
+	if !plan.TTL.IsUnknown() {
+		settings.TTL = int(plan.TTL.ValueInt32())
+	}
+
+	return settings
+}
+
+// zoneSettings returns the settings of the zone, or empty settings if the zone has none.
+func zoneSettings(zone *abionclient.Zone) abionclient.Settings {
+	if zone == nil || zone.Attributes.Settings == nil {
+		return abionclient.Settings{}
+	}
+	return *zone.Attributes.Settings
+}
+
+// settingsToModel sets the settings attributes of the model from the zone settings.
+func settingsToModel(settings abionclient.Settings, model *dnsZoneSettingsResourceModel) {
+	model.MName = types.StringValue(settings.MName)
+	model.Refresh = types.Int32Value(int32(settings.Refresh))
+	model.Expire = types.Int32Value(int32(settings.Expire))
+	model.TTL = types.Int32Value(int32(settings.TTL))
+}
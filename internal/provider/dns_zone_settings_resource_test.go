@@ -0,0 +1,65 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsZoneSettingsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Refresh outside of the recommended range
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_settings" "test" {
+			  zone    = "pmapitest4.com"
+			  refresh = 60
+			}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			// Create and Read testing, settings that are not configured keep their current value
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_settings" "test" {
+			  zone    = "pmapitest4.com"
+			  refresh = 7200
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "zone", "pmapitest4.com"),
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "refresh", "7200"),
+					resource.TestCheckResourceAttrSet("abion_dns_zone_settings.test", "mname"),
+					resource.TestCheckResourceAttrSet("abion_dns_zone_settings.test", "expire"),
+					resource.TestCheckResourceAttrSet("abion_dns_zone_settings.test", "ttl"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+			resource "abion_dns_zone_settings" "test" {
+			  zone    = "pmapitest4.com"
+			  refresh = 10800
+			  expire  = 1814400
+			  ttl     = 7200
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "zone", "pmapitest4.com"),
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "refresh", "10800"),
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "expire", "1814400"),
+					resource.TestCheckResourceAttr("abion_dns_zone_settings.test", "ttl", "7200"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,247 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"regexp"
+	"sort"
+	abionclient "terraform-provider-abion/internal/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// zonesPageSize is the number of zones requested per page from the Abion API.
+const zonesPageSize = 100
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dnsZonesDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZonesDataSource{}
+)
+
+// NewDnsZonesDataSource is a helper function to simplify the provider implementation.
+func NewDnsZonesDataSource() datasource.DataSource {
+	return &dnsZonesDataSource{}
+}
+
+// dnsZonesDataSource is the data source implementation.
+type dnsZonesDataSource struct {
+	client *abionclient.Client
+}
+
+// dnsZonesModel maps the data source schema data.
+type dnsZonesModel struct {
+	NameRegex      types.String      `tfsdk:"name_regex"`
+	OrganisationID types.String      `tfsdk:"organisation_id"`
+	Slave          types.Bool        `tfsdk:"slave"`
+	Pending        types.Bool        `tfsdk:"pending"`
+	Deleted        types.Bool        `tfsdk:"deleted"`
+	Names          []types.String    `tfsdk:"names"`
+	Zones          []ZoneSummaryData `tfsdk:"zones"`
+}
+
+type ZoneSummaryData struct {
+	Zone                    types.String `tfsdk:"zone"`
+	OrganisationID          types.String `tfsdk:"organisation_id"`
+	OrganisationDescription types.String `tfsdk:"organisation_description"`
+	DNSTypeDescription      types.String `tfsdk:"dns_type_description"`
+	Slave                   types.Bool   `tfsdk:"slave"`
+	Pending                 types.Bool   `tfsdk:"pending"`
+	Deleted                 types.Bool   `tfsdk:"deleted"`
+}
+
+func (d *dnsZonesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*AbionProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *AbionProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = providerData.Client
+}
+
+// Metadata returns the data source type name.
+func (d *dnsZonesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zones"
+}
+
+// Schema defines the schema for the data source.
+func (d *dnsZonesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the zones the API key has access to, optionally filtered.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A regular expression the zone names must match, e.g. `\\.se$`.",
+				Validators: []validator.String{
+					regexpValidator{},
+				},
+			},
+			"organisation_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list zones owned by the organisation with this ID.",
+			},
+			"slave": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list slave zones if true, or only zones that are not slave zones if false.",
+			},
+			"pending": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list zones with pending changes if true, or only zones without pending changes if false.",
+			},
+			"deleted": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Only list deleted zones if true, or only zones that are not deleted if false.",
+			},
+			"names": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The sorted names of the matching zones.",
+			},
+			"zones": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The matching zones, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"zone": schema.StringAttribute{
+							Computed:    true,
+							Description: "The name of the zone.",
+						},
+						"organisation_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the organisation owning the zone.",
+						},
+						"organisation_description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the organisation owning the zone.",
+						},
+						"dns_type_description": schema.StringAttribute{
+							Computed:    true,
+							Description: "The description of the DNS service type of the zone.",
+						},
+						"slave": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the zone is a slave zone, i.e. transferred from another primary name server.",
+						},
+						"pending": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether changes to the zone are pending.",
+						},
+						"deleted": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the zone is deleted.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dnsZonesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state dnsZonesModel
+
+	// Load filters from the configuration into state
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Regular Expression",
+				"The name_regex "+state.NameRegex.ValueString()+" is not a valid regular expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "Getting zones")
+
+	// Get all zones from Abion API
+	zones, err := d.client.GetAllZones(ctx, zonesPageSize)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Zones from Abion API",
+			err.Error(),
+		)
+		return
+	}
+
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].ID < zones[j].ID
+	})
+
+	state.Names = []types.String{}
+	state.Zones = []ZoneSummaryData{}
+
+	for _, zone := range zones {
+		if !zoneMatchesFilters(state, nameRegex, zone) {
+			continue
+		}
+
+		state.Names = append(state.Names, types.StringValue(zone.ID))
+		state.Zones = append(state.Zones, ZoneSummaryData{
+			Zone:                    types.StringValue(zone.ID),
+			OrganisationID:          types.StringValue(zone.Attributes.OrganisationID),
+			OrganisationDescription: types.StringValue(zone.Attributes.OrganisationDescription),
+			DNSTypeDescription:      types.StringValue(zone.Attributes.DNSTypeDescription),
+			Slave:                   types.BoolValue(zone.Attributes.Slave),
+			Pending:                 types.BoolValue(zone.Attributes.Pending),
+			Deleted:                 types.BoolValue(zone.Attributes.Deleted),
+		})
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d of %d zones matching the filters", len(state.Zones), len(zones)))
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// zoneMatchesFilters returns true if the zone matches all filters that are set in the configuration.
+func zoneMatchesFilters(filters dnsZonesModel, nameRegex *regexp.Regexp, zone abionclient.Zone) bool {
+	if nameRegex != nil && !nameRegex.MatchString(zone.ID) {
+		return false
+	}
+	if !filters.OrganisationID.IsNull() && filters.OrganisationID.ValueString() != zone.Attributes.OrganisationID {
+		return false
+	}
+	if !filters.Slave.IsNull() && filters.Slave.ValueBool() != zone.Attributes.Slave {
+		return false
+	}
+	if !filters.Pending.IsNull() && filters.Pending.ValueBool() != zone.Attributes.Pending {
+		return false
+	}
+	if !filters.Deleted.IsNull() && filters.Deleted.ValueBool() != zone.Attributes.Deleted {
+		return false
+	}
+	return true
+}
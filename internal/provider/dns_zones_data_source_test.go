@@ -0,0 +1,65 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDnsZonesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify all zones
+			{
+				Config: providerConfig + `
+			data "abion_dns_zones" "test_data" {
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckTypeSetElemAttr("data.abion_dns_zones.test_data", "names.*", "pmapitest1.com"),
+					resource.TestCheckTypeSetElemAttr("data.abion_dns_zones.test_data", "names.*", "pmapitest2.com"),
+				),
+			},
+			// Verify name filter
+			{
+				Config: providerConfig + `
+			data "abion_dns_zones" "test_data" {
+			  name_regex = "^pmapitest1\\.com$"
+			  slave      = false
+			}
+			`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Verify
+					resource.TestCheckResourceAttr("data.abion_dns_zones.test_data", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.abion_dns_zones.test_data", "names.0", "pmapitest1.com"),
+					resource.TestCheckResourceAttr("data.abion_dns_zones.test_data", "zones.#", "1"),
+					resource.TestCheckResourceAttr("data.abion_dns_zones.test_data", "zones.0.zone", "pmapitest1.com"),
+					resource.TestCheckResourceAttr("data.abion_dns_zones.test_data", "zones.0.slave", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDnsZonesInvalidRegexDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify error invalid regular expression
+			{
+				Config: providerConfig + `
+			data "abion_dns_zones" "test_data" {
+			  name_regex = "(("
+			}
+			`,
+				ExpectError: regexp.MustCompile("Invalid Regular Expression"),
+			},
+		},
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	pathpkg "path"
+	"strings"
+)
+
+// ZoneAllowed returns true if the zone matches one of the allowed_zones names or glob patterns of the provider.
+// All zones are allowed if allowed_zones is not configured.
+func (d *AbionProviderData) ZoneAllowed(zone string) bool {
+	if d.AllowedZones == nil {
+		return true
+	}
+
+	zone = normalizeZoneName(zone)
+	for _, pattern := range d.AllowedZones {
+		if matched, _ := pathpkg.Match(normalizeZoneName(pattern), zone); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeZoneName returns the zone name in lower case and without a trailing dot.
+func normalizeZoneName(zone string) string {
+	return strings.TrimSuffix(strings.ToLower(zone), ".")
+}
+
+// checkZoneWritable verifies that the provider allows changes to the zone, i.e. that the provider is not read only
+// and that the zone is allowed by allowed_zones.
+func checkZoneWritable(providerData *AbionProviderData, zone string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(checkNotReadOnly(providerData, zone)...)
+	diags.Append(checkZoneAllowed(providerData, path.Root("zone"), zone)...)
+
+	return diags
+}
+
+// checkNotReadOnly verifies that the provider is not configured as read only.
+func checkNotReadOnly(providerData *AbionProviderData, zone string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if providerData.ReadOnly {
+		diags.AddError(
+			"Provider is read only",
+			"The provider is configured with read_only = true, resources can't be created, updated or deleted. "+
+				"Zone: "+zone,
+		)
+	}
+
+	return diags
+}
+
+// checkZoneAllowed verifies that the zone is allowed by the allowed_zones setting of the provider.
+func checkZoneAllowed(providerData *AbionProviderData, attributePath path.Path, zone string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !providerData.ZoneAllowed(zone) {
+		diags.AddAttributeError(
+			attributePath,
+			"Zone not allowed",
+			"The zone "+zone+" does not match any of the allowed_zones of the provider: "+
+				strings.Join(providerData.AllowedZones, ", "),
+		)
+	}
+
+	return diags
+}
+
+// modifyPlanGuards validates a planned create, update or delete of a resource against the allowed_zones and
+// read_only settings of the provider, so that a disallowed change fails at plan time.
+func modifyPlanGuards(ctx context.Context, providerData *AbionProviderData, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// The provider has not been configured yet, e.g. during validation
+	if providerData == nil {
+		return
+	}
+
+	var zone types.String
+	if req.Plan.Raw.IsNull() {
+		// Resource is being destroyed, verify the zone of the current state
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	} else {
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("zone"), &zone)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown zone is verified when the resource is applied
+	if !zone.IsNull() && !zone.IsUnknown() {
+		resp.Diagnostics.Append(checkZoneAllowed(providerData, path.Root("zone"), zone.ValueString())...)
+	}
+
+	// Only fail read only providers if the resource is actually changed
+	changed := req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || !req.Plan.Raw.Equal(req.State.Raw)
+	if changed {
+		resp.Diagnostics.Append(checkNotReadOnly(providerData, zone.ValueString())...)
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestZoneAllowed(t *testing.T) {
+	tests := []struct {
+		allowedZones []string
+		zone         string
+		allowed      bool
+	}{
+		{nil, "example.com", true},
+		{[]string{}, "example.com", false},
+		{[]string{"example.com"}, "example.com", true},
+		{[]string{"example.com"}, "Example.COM.", true},
+		{[]string{"example.com"}, "example.org", false},
+		{[]string{"*.example.com"}, "team.example.com", true},
+		{[]string{"*.example.com"}, "example.com", false},
+		{[]string{"team-*.com", "example.org"}, "team-a.com", true},
+		{[]string{"team-*.com", "example.org"}, "example.org", true},
+		{[]string{"team-?.com"}, "team-ab.com", false},
+	}
+
+	for _, test := range tests {
+		providerData := &AbionProviderData{AllowedZones: test.allowedZones}
+		if allowed := providerData.ZoneAllowed(test.zone); allowed != test.allowed {
+			t.Errorf("ZoneAllowed(%q) with allowed zones %v = %t, expected %t", test.zone, test.allowedZones, allowed, test.allowed)
+		}
+	}
+}
+
+func TestAccAllowedZonesGuard(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify error when the zone is not allowed
+			{
+				Config: `
+			provider "abion" {
+			  allowed_zones = ["pmapitest2.com", "*.pmapitest2.com"]
+			}
+
+			resource "abion_dns_a_record" "test" {
+			  zone  = "pmapitest1.com"
+			  name = "@"
+			  records = [
+				{
+				  ip_address = "203.0.113.0"
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Zone not allowed`),
+			},
+		},
+	})
+}
+
+func TestAccReadOnlyGuard(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Verify error when creating a resource with a read only provider
+			{
+				Config: `
+			provider "abion" {
+			  read_only = true
+			}
+
+			resource "abion_dns_a_record" "test" {
+			  zone  = "pmapitest1.com"
+			  name = "@"
+			  records = [
+				{
+				  ip_address = "203.0.113.0"
+				},
+			  ]
+			}
+			`,
+				ExpectError: regexp.MustCompile(`Provider is read only`),
+			},
+		},
+	})
+}
@@ -5,17 +5,21 @@ package provider
 
 import (
 	"context"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"os"
-	"strconv"
 	abionclient "terraform-provider-abion/internal/client"
+	"time"
 )
 
 // Ensure AbionDnsProvider satisfies various provider interfaces.
@@ -32,9 +36,28 @@ type AbionDnsProvider struct {
 
 // AbionProviderModel describes the provider data model.
 type AbionProviderModel struct {
-	Host    types.String `tfsdk:"host"`
-	Apikey  types.String `tfsdk:"apikey"`
-	Timeout types.Int32  `tfsdk:"timeout"`
+	Host                      types.String `tfsdk:"host"`
+	AllowInsecureHTTP         types.Bool   `tfsdk:"allow_insecure_http"`
+	Apikey                    types.String `tfsdk:"apikey"`
+	Timeout                   types.Int32  `tfsdk:"timeout"`
+	WaitForConsistency        types.Bool   `tfsdk:"wait_for_consistency"`
+	ConsistencyTimeout        types.Int32  `tfsdk:"consistency_timeout"`
+	AllowedZones              types.List   `tfsdk:"allowed_zones"`
+	ReadOnly                  types.Bool   `tfsdk:"read_only"`
+	DefaultTTL                types.Int32  `tfsdk:"default_ttl"`
+	DefaultComments           types.String `tfsdk:"default_comments"`
+	SkipCredentialsValidation types.Bool   `tfsdk:"skip_credentials_validation"`
+}
+
+// AbionProviderData is made available to data sources and resources during their Configure methods.
+type AbionProviderData struct {
+	Client             *abionclient.Client
+	WaitForConsistency bool
+	ConsistencyTimeout time.Duration
+	AllowedZones       []string
+	ReadOnly           bool
+	DefaultTTL         types.Int32
+	DefaultComments    types.String
 }
 
 // Metadata returns the provider type name.
@@ -51,8 +74,15 @@ func (p *AbionDnsProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "The Abion API host URL. If not set, defaults to `https://api.abion.com`. " +
 					"This value can also be set using the `ABION_API_HOST` environment variable. " +
 					"The order of precedence: Terraform configuration value (highest priority) > " +
-					"environment variable > default value.",
+					"environment variable > default value. Must be an absolute `https` URL.",
 				Optional: true,
+				Validators: []validator.String{
+					hostValidator{},
+				},
+			},
+			"allow_insecure_http": schema.BoolAttribute{
+				MarkdownDescription: "Allow an `http` host URL, e.g. for a local test server. If not set, defaults to `false`.",
+				Optional:            true,
 			},
 			"apikey": schema.StringAttribute{
 				MarkdownDescription: "The Abion API key. Contact [Abion](https://abion.com) for help on " +
@@ -62,12 +92,68 @@ func (p *AbionDnsProvider) Schema(ctx context.Context, req provider.SchemaReques
 					"environment variable (lowest priority). ",
 				Optional:  true,
 				Sensitive: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
 			},
 			"timeout": schema.Int32Attribute{
 				MarkdownDescription: "The Abion API timeout in seconds. If not set, defaults to `60`. " +
 					"This value can also be set using the `ABION_API_TIMEOUT` environment variable. " +
 					"The order of precedence: Terraform configuration value (highest priority) > " +
-					"environment variable > default value.",
+					"environment variable > default value. Must be between `1` and `600`.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(minTimeout, maxTimeout),
+				},
+			},
+			"wait_for_consistency": schema.BoolAttribute{
+				MarkdownDescription: "Wait for record changes to become visible in the zone, and for the zone to no longer " +
+					"be pending, before finishing create and update of a resource. If not set, defaults to `false`. " +
+					"Can be overridden by the `wait_for_consistency` attribute of each resource.",
+				Optional: true,
+			},
+			"consistency_timeout": schema.Int32Attribute{
+				MarkdownDescription: "The maximum time in seconds to wait for changes to become visible when " +
+					"`wait_for_consistency` is enabled. If not set, defaults to `300`. Must be between `1` and `3600`.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(minConsistencyTimeout, maxConsistencyTimeout),
+				},
+			},
+			"allowed_zones": schema.ListAttribute{
+				MarkdownDescription: "The zones resources of this provider are allowed to manage, as zone names or glob " +
+					"patterns, e.g. `example.com` or `*.example.com`. Resources targeting any other zone fail at plan time. " +
+					"If not set, all zones are allowed. Data sources are not restricted.",
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1), globPatternValidator{}),
+				},
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Turn every create, update and delete of a resource into an error, while data sources " +
+					"keep working. If not set, defaults to `false`.",
+				Optional: true,
+			},
+			"default_ttl": schema.Int32Attribute{
+				MarkdownDescription: "The time-to-live (TTL) in seconds planned for every record of a resource that " +
+					"leaves `ttl` unset. A `ttl` set on a record always takes precedence.",
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.AtLeast(1),
+				},
+			},
+			"default_comments": schema.StringAttribute{
+				MarkdownDescription: "The comments planned for every record of a resource that leaves `comments` unset. " +
+					"`comments` set on a record always take precedence.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"skip_credentials_validation": schema.BoolAttribute{
+				MarkdownDescription: "Skip the validation of the API key, IP whitelisting and connectivity to the Abion API " +
+					"when the provider is configured, e.g. to run plans offline. If not set, defaults to `false`.",
 				Optional: true,
 			},
 		},
@@ -95,6 +181,15 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.AllowInsecureHTTP.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allow_insecure_http"),
+			"Unknown allow insecure HTTP setting",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for allow_insecure_http. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if config.Timeout.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("timeout"),
@@ -104,6 +199,69 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 		)
 	}
 
+	if config.WaitForConsistency.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("wait_for_consistency"),
+			"Unknown wait for consistency setting",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for wait_for_consistency. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ConsistencyTimeout.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("consistency_timeout"),
+			"Unknown consistency timeout",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for consistency_timeout. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.AllowedZones.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("allowed_zones"),
+			"Unknown allowed zones",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for allowed_zones. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ReadOnly.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("read_only"),
+			"Unknown read only setting",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for read_only. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DefaultTTL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_ttl"),
+			"Unknown default TTL",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for default_ttl. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.DefaultComments.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("default_comments"),
+			"Unknown default comments",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for default_comments. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.SkipCredentialsValidation.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("skip_credentials_validation"),
+			"Unknown skip credentials validation setting",
+			"The provider cannot create the Abion API client as there is an unknown configuration value for skip_credentials_validation. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
 	if config.Apikey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("apikey"),
@@ -128,6 +286,15 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 		host = "https://api.abion.com"
 	}
 
+	// Validate the host regardless of its source, all invalid values are reported together before returning
+	if err := validateHost(host, config.AllowInsecureHTTP.ValueBool()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("host"),
+			"Invalid Abion API Host",
+			"The Abion API host "+host+" from the configuration or the ABION_API_HOST environment variable "+err.Error()+".",
+		)
+	}
+
 	// Environment variable ABION_API_TIMEOUT
 	timeoutEnv := os.Getenv("ABION_API_TIMEOUT")
 
@@ -136,21 +303,46 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 		// If Terraform configuration is set, use it
 		timeout = int(config.Timeout.ValueInt32())
 	} else if timeoutEnv != "" {
-		// If ABION_API_TIMEOUT environment variable is set, convert it
-		timeoutInt, err := strconv.Atoi(timeoutEnv)
+		// If ABION_API_TIMEOUT environment variable is set, convert and validate it
+		timeoutEnvInt, err := parseTimeout(timeoutEnv, minTimeout, maxTimeout)
 		if err != nil {
 			resp.Diagnostics.AddAttributeError(
 				path.Root("timeout"),
 				"Invalid ABION_API_TIMEOUT value in environment",
-				"Must be an integer.",
+				"The ABION_API_TIMEOUT environment variable value "+timeoutEnv+" "+err.Error()+".",
 			)
 		}
-		timeout = timeoutInt
+		timeout = timeoutEnvInt
 	} else {
 		// Use the default value
 		timeout = 60
 	}
 
+	waitForConsistency := false
+	if !config.WaitForConsistency.IsNull() {
+		waitForConsistency = config.WaitForConsistency.ValueBool()
+	}
+
+	consistencyTimeout := 300
+	if !config.ConsistencyTimeout.IsNull() {
+		consistencyTimeout = int(config.ConsistencyTimeout.ValueInt32())
+	}
+
+	var allowedZones []string
+	if !config.AllowedZones.IsNull() {
+		resp.Diagnostics.Append(config.AllowedZones.ElementsAs(ctx, &allowedZones, false)...)
+
+		if allowedZones == nil {
+			// An empty list allows no zones at all
+			allowedZones = []string{}
+		}
+	}
+
+	readOnly := false
+	if !config.ReadOnly.IsNull() {
+		readOnly = config.ReadOnly.ValueBool()
+	}
+
 	apikey := os.Getenv("ABION_API_KEY")
 
 	if !config.Apikey.IsNull() {
@@ -174,6 +366,12 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 	ctx = tflog.SetField(ctx, "abion_host", host)
 	ctx = tflog.SetField(ctx, "abion_apikey", apikey)
 	ctx = tflog.SetField(ctx, "timeout", timeout)
+	ctx = tflog.SetField(ctx, "wait_for_consistency", waitForConsistency)
+	ctx = tflog.SetField(ctx, "consistency_timeout", consistencyTimeout)
+	ctx = tflog.SetField(ctx, "allowed_zones", allowedZones)
+	ctx = tflog.SetField(ctx, "read_only", readOnly)
+	ctx = tflog.SetField(ctx, "default_ttl", config.DefaultTTL.ValueInt32())
+	ctx = tflog.SetField(ctx, "default_comments", config.DefaultComments.ValueString())
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "abion_apikey")
 
 	tflog.Debug(ctx, "Creating Abion client")
@@ -191,10 +389,28 @@ func (p *AbionDnsProvider) Configure(ctx context.Context, req provider.Configure
 		return
 	}
 
-	// Make the Abion client available during DataSource and Resource
+	// Verify the credentials and connectivity once, instead of failing every resource and data source
+	if !config.SkipCredentialsValidation.ValueBool() {
+		resp.Diagnostics.Append(validateCredentials(ctx, client, host)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	providerData := &AbionProviderData{
+		Client:             client,
+		WaitForConsistency: waitForConsistency,
+		ConsistencyTimeout: time.Duration(consistencyTimeout) * time.Second,
+		AllowedZones:       allowedZones,
+		ReadOnly:           readOnly,
+		DefaultTTL:         config.DefaultTTL,
+		DefaultComments:    config.DefaultComments,
+	}
+
+	// Make the Abion client and settings available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 
 	tflog.Info(ctx, "Configured Abion client", map[string]any{"success": true})
 }
@@ -211,6 +427,20 @@ func (p *AbionDnsProvider) Resources(ctx context.Context) []func() resource.Reso
 		NewDnsSRVRecordResource,
 		NewDnsPTRRecordResource,
 		NewDnsCAARecordResource,
+		NewDnsZoneSettingsResource,
+		NewDnsRedirectResource,
+		NewDnsRecordResource,
+		NewDnsZoneRecordsResource,
+		NewDnsRecordMemberResource,
+		NewDnsZoneFileResource,
+		NewDnsAcmeChallengeResource,
+		NewDnsDelegationResource,
+		NewDnsPtrForIPResource,
+		NewDnsDmarcPolicyResource,
+		NewDnsDkimKeyResource,
+		NewDnsMtaStsResource,
+		NewDnsSpfResource,
+		NewDnsCAAPolicyResource,
 	}
 }
 
@@ -226,6 +456,10 @@ func (p *AbionDnsProvider) DataSources(ctx context.Context) []func() datasource.
 		NewDnsSRVRecordDataSource,
 		NewDnsPTRRecordDataSource,
 		NewDnsCAARecordDataSource,
+		NewDnsZoneDataSource,
+		NewDnsZonesDataSource,
+		NewDnsRedirectsDataSource,
+		NewDnsZoneFileDataSource,
 	}
 }
 
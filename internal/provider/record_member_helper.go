@@ -0,0 +1,158 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"slices"
+	"sync"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// zoneLocks serializes the read-modify-write cycles of record members of the same zone, as Terraform applies
+// resources in parallel and a merge patch always replaces the whole record set.
+var zoneLocks sync.Map
+
+// lockZone locks the zone for record member changes and returns the function that unlocks it.
+func lockZone(zone string) func() {
+	mutex, _ := zoneLocks.LoadOrStore(normalizeZoneName(zone), &sync.Mutex{})
+	mutex.(*sync.Mutex).Lock()
+	return mutex.(*sync.Mutex).Unlock
+}
+
+// findRecordMember returns the index of the record with the rdata in the records, or -1 if there is none.
+func findRecordMember(records []abionclient.Record, rdata string) int {
+	for i, record := range records {
+		if record.Data == rdata {
+			return i
+		}
+	}
+	return -1
+}
+
+// putRecordMember adds the record to the record set of the name and type, or updates the ttl and comments of the
+// record with the same rdata, and leaves all other records of the set untouched. It returns the patch sent to the
+// zone, or an empty patch if the record set was already up to date.
+func putRecordMember(ctx context.Context, client *abionclient.Client, zone string, name string, recordType utils.RecordType, record abionclient.Record) (abionclient.ZoneRequest, error) {
+	return updateRecordMembers(ctx, client, zone, name, recordType, []abionclient.Record{record}, nil)
+}
+
+// removeRecordMember removes the record with the rdata from the record set of the name and type, and leaves all
+// other records of the set untouched. The record type is removed from the name when its last record is removed.
+func removeRecordMember(ctx context.Context, client *abionclient.Client, zone string, name string, recordType utils.RecordType, rdata string) error {
+	_, err := updateRecordMembers(ctx, client, zone, name, recordType, nil, []string{rdata})
+	return err
+}
+
+// updateRecordMembers adds or updates the put records and removes the records with the rdata of remove in a single
+// patch of the record set of the name and type, and leaves all other records of the set untouched. The record type
+// is removed from the name when its last record is removed. It returns the patch sent to the zone, or an empty patch
+// if the record set was already up to date.
+func updateRecordMembers(ctx context.Context, client *abionclient.Client, zone string, name string, recordType utils.RecordType, put []abionclient.Record, remove []string) (abionclient.ZoneRequest, error) {
+	return updateRecordMembersFunc(ctx, client, zone, name, recordType, put, func(record abionclient.Record) bool {
+		return slices.Contains(remove, record.Data)
+	})
+}
+
+// updateRecordMembersFunc works like updateRecordMembers, but removes the records for which remove returns true.
+func updateRecordMembersFunc(ctx context.Context, client *abionclient.Client, zone string, name string, recordType utils.RecordType, put []abionclient.Record, remove func(abionclient.Record) bool) (abionclient.ZoneRequest, error) {
+	unlock := lockZone(zone)
+	defer unlock()
+
+	current, err := client.GetZone(ctx, zone)
+	if err != nil {
+		return abionclient.ZoneRequest{}, err
+	}
+
+	var data []abionclient.Record
+	for _, record := range current.Data.Attributes.Records[name][recordType.String()] {
+		if !remove(record) {
+			data = append(data, record)
+		}
+	}
+
+	for _, record := range put {
+		if i := findRecordMember(data, record.Data); i >= 0 {
+			data[i] = record
+		} else {
+			data = append(data, record)
+		}
+	}
+
+	patchRequest := abionclient.CreateRecordPatchRequest(zone, name, recordType, data)
+	if abionclient.IsEmptyPatch(abionclient.DiffZones(*current.Data, abionclient.ApplyZonePatch(*current.Data, patchRequest))) {
+		return abionclient.ZoneRequest{}, nil
+	}
+
+	_, err = client.PatchZone(ctx, zone, patchRequest)
+	if err != nil {
+		return abionclient.ZoneRequest{}, err
+	}
+
+	return patchRequest, nil
+}
+
+// waitForRecordMembers waits until the records with the rdata of present are visible in the record set of the name
+// and type, and the records with the rdata of absent are not. Unlike waitForConsistency it ignores the other records
+// of the set, which may be changed concurrently. Waiting is enabled by the resourceWait attribute of the resource
+// or, if not set on the resource, by the provider configuration.
+func waitForRecordMembers(ctx context.Context, providerData *AbionProviderData, resourceWait types.Bool, zone string, name string, recordType utils.RecordType, present []string, absent []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	wait := providerData.WaitForConsistency
+	if !resourceWait.IsNull() && !resourceWait.IsUnknown() {
+		wait = resourceWait.ValueBool()
+	}
+
+	if !wait {
+		return diags
+	}
+
+	ctx = tflog.SetField(ctx, "consistency_timeout", providerData.ConsistencyTimeout.String())
+	tflog.Debug(ctx, "Waiting for record members to become visible")
+
+	err := providerData.Client.WaitForZone(ctx, zone, providerData.ConsistencyTimeout, func(current abionclient.Zone) error {
+		records := current.Attributes.Records[name][recordType.String()]
+		for _, rdata := range present {
+			if findRecordMember(records, rdata) < 0 {
+				return fmt.Errorf("record %s of %s is not visible yet", rdata, name)
+			}
+		}
+		for _, rdata := range absent {
+			if findRecordMember(records, rdata) >= 0 {
+				return fmt.Errorf("record %s of %s is still visible", rdata, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		diags.AddError(
+			"Zone changes not visible",
+			"The zone was patched, but the changes did not become visible before the consistency timeout passed: "+err.Error(),
+		)
+	}
+
+	return diags
+}
+
+// readRecordMember returns the record with the rdata of the record set of the name and type, or nil if there is none.
+func readRecordMember(ctx context.Context, client *abionclient.Client, zone string, name string, recordType utils.RecordType, rdata string) (*abionclient.Record, error) {
+	current, err := client.GetZone(ctx, zone)
+	if err != nil {
+		return nil, err
+	}
+
+	records := current.Data.Attributes.Records[name][recordType.String()]
+
+	i := findRecordMember(records, rdata)
+	if i < 0 {
+		return nil, nil
+	}
+	return &records[i], nil
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+)
+
+// newTestMemberServer returns a client of a server that stores a single zone and applies patches to it. Requests
+// are delayed to widen the window for lost updates of concurrent read-modify-write cycles.
+func newTestMemberServer(t *testing.T, zone abionclient.Zone) (*abionclient.Client, func() abionclient.Zone) {
+	var mutex sync.Mutex
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(time.Millisecond)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if r.Method == http.MethodPatch {
+			var patch abionclient.ZoneRequest
+			if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			zone = abionclient.ApplyZonePatch(zone, patch)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(abionclient.APIResponse[*abionclient.Zone]{Data: &zone})
+	}))
+	t.Cleanup(server.Close)
+
+	client, err := abionclient.NewAbionClient(server.URL, "test", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return client, func() abionclient.Zone {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return zone
+	}
+}
+
+func TestRecordMembersConcurrent(t *testing.T) {
+	zone := abionclient.Zone{ID: "example.com", Attributes: abionclient.Attributes{
+		Records: map[string]map[string][]abionclient.Record{"@": {"TXT": {{Data: "manual"}}}},
+	}}
+	client, current := newTestMemberServer(t, zone)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			record := abionclient.Record{Data: fmt.Sprintf("value%d", i)}
+			if _, err := putRecordMember(context.Background(), client, "example.com", "@", utils.RecordTypeTXT, record); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	records := current().Attributes.Records["@"]["TXT"]
+	if len(records) != 11 {
+		t.Fatalf("expected 11 records, got %d: %v", len(records), records)
+	}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := removeRecordMember(context.Background(), client, "example.com", "@", utils.RecordTypeTXT, fmt.Sprintf("value%d", i)); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	records = current().Attributes.Records["@"]["TXT"]
+	if len(records) != 1 || records[0].Data != "manual" {
+		t.Fatalf("expected only the manual record, got %v", records)
+	}
+}
+
+func TestPutRecordMemberUpToDate(t *testing.T) {
+	ttl := 300
+	zone := abionclient.Zone{ID: "example.com", Attributes: abionclient.Attributes{
+		Records: map[string]map[string][]abionclient.Record{"@": {"TXT": {{Data: "value", TTL: &ttl}}}},
+	}}
+	client, _ := newTestMemberServer(t, zone)
+
+	patch, err := putRecordMember(context.Background(), client, "example.com", "@", utils.RecordTypeTXT, abionclient.Record{Data: "value", TTL: &ttl})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !abionclient.IsEmptyPatch(patch) {
+		t.Errorf("expected an empty patch, got %v", patch)
+	}
+}
@@ -0,0 +1,15 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "time"
+
+// Default operation timeouts, used when a resource does not configure them in its timeouts block. The timeouts
+// cover all API requests of an operation, including waiting for consistency.
+const (
+	defaultCreateTimeout = 20 * time.Minute
+	defaultReadTimeout   = 5 * time.Minute
+	defaultUpdateTimeout = 20 * time.Minute
+	defaultDeleteTimeout = 20 * time.Minute
+)
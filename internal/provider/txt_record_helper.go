@@ -0,0 +1,120 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+	"terraform-provider-abion/internal/utils"
+	"terraform-provider-abion/internal/zonefile"
+)
+
+// txtRecordData returns the rdata of a TXT record with the text. Text longer than a single character string is split
+// into quoted character strings.
+func txtRecordData(text string) string {
+	if len(text) <= zonefile.MaxStringLength {
+		return text
+	}
+	return zonefile.QuoteTXT(text)
+}
+
+// findTXTRecords returns the TXT records for which the text, with character strings joined, matches.
+func findTXTRecords(records []abionclient.Record, match func(text string) bool) []abionclient.Record {
+	var found []abionclient.Record
+	for _, record := range records {
+		if match(zonefile.UnquoteTXT(record.Data)) {
+			found = append(found, record)
+		}
+	}
+	return found
+}
+
+// txtRecordsText returns the texts of the records, with character strings joined, one per line. A name with more
+// than one matching record thus has a text that differs from the planned one, and the other records are removed
+// when the resource is applied.
+func txtRecordsText(records []abionclient.Record) string {
+	texts := make([]string, 0, len(records))
+	for _, record := range records {
+		texts = append(texts, zonefile.UnquoteTXT(record.Data))
+	}
+	return strings.Join(texts, "\n")
+}
+
+// replaceTXTRecords replaces the TXT records of the name for which the text matches with the record, and leaves the
+// other TXT records of the name untouched. A nil record removes the matching records. It returns the patch sent to
+// the zone, or an empty patch if the records were already up to date.
+func replaceTXTRecords(ctx context.Context, client *abionclient.Client, zone string, name string, match func(text string) bool, record *abionclient.Record) (abionclient.ZoneRequest, error) {
+	var put []abionclient.Record
+	if record != nil {
+		put = append(put, *record)
+	}
+
+	// An identical record is updated rather than replaced, to keep the order of the records
+	return updateRecordMembersFunc(ctx, client, zone, name, utils.RecordTypeTXT, put, func(current abionclient.Record) bool {
+		return (record == nil || current.Data != record.Data) && match(zonefile.UnquoteTXT(current.Data))
+	})
+}
+
+// txtTag is a tag of a tag list, the format of e.g. DKIM, DMARC and MTA-STS records.
+type txtTag struct {
+	Name  string
+	Value string
+}
+
+// parseTagList parses a list of tag=value pairs separated by semicolons. The names are returned in lower case, and
+// whitespace around names and values is removed.
+func parseTagList(text string) ([]txtTag, error) {
+	var tags []txtTag
+
+	for _, tag := range strings.Split(text, ";") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+
+		name, value, found := strings.Cut(tag, "=")
+		if !found {
+			return nil, fmt.Errorf("the tag %s has no value", tag)
+		}
+
+		tags = append(tags, txtTag{
+			Name:  strings.ToLower(strings.TrimSpace(name)),
+			Value: strings.TrimSpace(value),
+		})
+	}
+
+	return tags, nil
+}
+
+// splitTagValue returns the values of a tag value list, e.g. the colon separated list of a DKIM h tag.
+func splitTagValue(value string, separator string) []string {
+	values := strings.Split(value, separator)
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values
+}
+
+// parseTagKeyword returns the value of the tag in lower case if it is one of the keywords.
+func parseTagKeyword(tag string, value string, keywords []string) (string, error) {
+	value = strings.ToLower(value)
+	for _, keyword := range keywords {
+		if value == keyword {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("the value %s of the tag %s is not one of %s", value, tag, strings.Join(keywords, ", "))
+}
+
+// parseTagInt returns the value of the tag if it is an integer between minValue and maxValue.
+func parseTagInt(tag string, value string, minValue int, maxValue int) (*int, error) {
+	i, err := strconv.Atoi(value)
+	if err != nil || i < minValue || i > maxValue {
+		return nil, fmt.Errorf("the value %s of the tag %s is not an integer between %d and %d", value, tag, minValue, maxValue)
+	}
+	return &i, nil
+}
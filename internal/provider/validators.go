@@ -0,0 +1,276 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"net/netip"
+	"net/url"
+	pathpkg "path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Bounds of the provider timeouts, in seconds.
+const (
+	minTimeout            = 1
+	maxTimeout            = 600
+	minConsistencyTimeout = 1
+	maxConsistencyTimeout = 3600
+)
+
+// validateHost verifies that the host is an absolute https URL, or an http URL if allowInsecureHTTP is set.
+func validateHost(host string, allowInsecureHTTP bool) error {
+	if err := validateHostURL(host); err != nil {
+		return err
+	}
+
+	u, _ := url.Parse(host)
+	if u.Scheme == "http" && !allowInsecureHTTP {
+		return errors.New("must use the https scheme, http is only allowed when allow_insecure_http is set")
+	}
+
+	return nil
+}
+
+// validateHostURL verifies that the host is an absolute http or https URL without query or fragment.
+func validateHostURL(host string) error {
+	u, err := url.Parse(host)
+	if err != nil {
+		return fmt.Errorf("must be a valid URL: %w", err)
+	}
+
+	if !u.IsAbs() || u.Host == "" {
+		return errors.New("must be an absolute URL, e.g. https://api.abion.com")
+	}
+
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("must use the https scheme, got %s", u.Scheme)
+	}
+
+	if u.User != nil || u.RawQuery != "" || u.Fragment != "" {
+		return errors.New("must not contain user information, a query or a fragment")
+	}
+
+	return nil
+}
+
+// parseTimeout parses a timeout in seconds and verifies that it is within the bounds.
+func parseTimeout(value string, minValue int, maxValue int) (int, error) {
+	timeout, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, errors.New("must be an integer")
+	}
+
+	if timeout < minValue || timeout > maxValue {
+		return 0, fmt.Errorf("must be between %d and %d seconds, got %d", minValue, maxValue, timeout)
+	}
+
+	return timeout, nil
+}
+
+var _ validator.String = hostValidator{}
+
+// hostValidator validates that a string attribute is an absolute http or https URL. Whether http is allowed is
+// verified when the provider is configured, as it depends on the allow_insecure_http attribute.
+type hostValidator struct{}
+
+func (v hostValidator) Description(_ context.Context) string {
+	return "value must be an absolute https URL"
+}
+
+func (v hostValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hostValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := validateHostURL(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Abion API Host",
+			"The host "+req.ConfigValue.ValueString()+" "+err.Error()+".",
+		)
+	}
+}
+
+var _ validator.String = globPatternValidator{}
+
+// globPatternValidator validates that a string attribute is a valid glob pattern.
+type globPatternValidator struct{}
+
+func (v globPatternValidator) Description(_ context.Context) string {
+	return "value must be a valid glob pattern"
+}
+
+func (v globPatternValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v globPatternValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := pathpkg.Match(req.ConfigValue.ValueString(), ""); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Glob Pattern",
+			"The pattern "+req.ConfigValue.ValueString()+" is not a valid glob pattern: "+err.Error(),
+		)
+	}
+}
+
+// hostnameRegexp matches a fully qualified host name, with or without a trailing dot.
+var hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.?$`)
+
+var _ validator.String = regexpValidator{}
+
+// regexpValidator validates that a string attribute is a valid regular expression.
+type regexpValidator struct{}
+
+func (v regexpValidator) Description(_ context.Context) string {
+	return "value must be a valid regular expression"
+}
+
+func (v regexpValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v regexpValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			"The value "+req.ConfigValue.ValueString()+" is not a valid regular expression: "+err.Error(),
+		)
+	}
+}
+
+var _ validator.String = urlValidator{}
+
+// urlValidator validates that a string attribute is an absolute http or https URL.
+type urlValidator struct{}
+
+func (v urlValidator) Description(_ context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v urlValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v urlValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	u, err := url.Parse(req.ConfigValue.ValueString())
+	if err != nil || !u.IsAbs() || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			"The value "+req.ConfigValue.ValueString()+" is not an absolute http or https URL, e.g. https://www.example.com/.",
+		)
+	}
+}
+
+// recordTypeRegexp matches a DNS record type name in upper case, e.g. A, TXT or TLSA.
+var recordTypeRegexp = regexp.MustCompile(`^[A-Z][A-Z0-9]*$`)
+
+// domainRegexp matches a fully qualified domain name or a wildcard domain name, with or without a trailing dot.
+var domainRegexp = regexp.MustCompile(`^(\*\.)?` + strings.TrimPrefix(hostnameRegexp.String(), "^"))
+
+// acmeDigestRegexp matches the base64url encoded SHA-256 digest of an ACME key authorization.
+var acmeDigestRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+var _ validator.String = ipAddressValidator{}
+
+// ipAddressValidator validates that a string attribute is an IP address of the version, 4 or 6, or of any version if
+// the version is 0.
+type ipAddressValidator struct {
+	version int
+}
+
+func (v ipAddressValidator) Description(_ context.Context) string {
+	switch v.version {
+	case 4:
+		return "value must be an IPv4 address"
+	case 6:
+		return "value must be an IPv6 address"
+	default:
+		return "value must be an IPv4 or IPv6 address"
+	}
+}
+
+func (v ipAddressValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipAddressValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	addr, err := netip.ParseAddr(req.ConfigValue.ValueString())
+	if err != nil || addr.Zone() != "" || (v.version == 4 && !addr.Is4()) || (v.version == 6 && !addr.Is6()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			"The "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
+
+var _ validator.String = ipPrefixValidator{}
+
+// ipPrefixValidator validates that a string attribute is an IP address or an IP network in CIDR notation of the
+// version, 4 or 6.
+type ipPrefixValidator struct {
+	version int
+}
+
+func (v ipPrefixValidator) Description(_ context.Context) string {
+	if v.version == 6 {
+		return "value must be an IPv6 address or network, e.g. 2001:db8::/32"
+	}
+	return "value must be an IPv4 address or network, e.g. 192.0.2.0/24"
+}
+
+func (v ipPrefixValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v ipPrefixValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var addr netip.Addr
+	prefix, err := netip.ParsePrefix(req.ConfigValue.ValueString())
+	if err == nil {
+		addr = prefix.Addr()
+	} else {
+		addr, err = netip.ParseAddr(req.ConfigValue.ValueString())
+	}
+
+	if err != nil || addr.Zone() != "" || (v.version == 4 && !addr.Is4()) || (v.version == 6 && !addr.Is6()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid IP Address",
+			"The "+v.Description(ctx)+", got: "+req.ConfigValue.ValueString(),
+		)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	abionclient "terraform-provider-abion/internal/client"
+)
+
+// lookupZone returns the zone of the domain, i.e. the zone with the longest name that the domain is within, or an
+// empty string if none of the zones of the account contains the domain.
+func lookupZone(ctx context.Context, client *abionclient.Client, domain string) (string, error) {
+	zones, err := client.GetAllZones(ctx, zonesPageSize)
+	if err != nil {
+		return "", err
+	}
+
+	names := make([]string, 0, len(zones))
+	for _, zone := range zones {
+		names = append(names, zone.ID)
+	}
+
+	return longestMatchingZone(names, domain), nil
+}
+
+// longestMatchingZone returns the zone with the longest name that the domain is within, or an empty string if the
+// domain is not within any of the zones.
+func longestMatchingZone(zones []string, domain string) string {
+	domain = normalizeZoneName(domain)
+
+	match := ""
+	for _, zone := range zones {
+		name := normalizeZoneName(zone)
+		if (domain == name || strings.HasSuffix(domain, "."+name)) && len(name) > len(normalizeZoneName(match)) {
+			match = zone
+		}
+	}
+	return match
+}
+
+// relativeRecordName returns the name of the domain relative to the zone, "@" for the zone itself. It returns false
+// if the domain is not within the zone.
+func relativeRecordName(domain string, zone string) (string, bool) {
+	domain = normalizeZoneName(domain)
+	zone = normalizeZoneName(zone)
+
+	if domain == zone {
+		return "@", true
+	}
+	if name, found := strings.CutSuffix(domain, "."+zone); found {
+		return name, true
+	}
+	return "", false
+}
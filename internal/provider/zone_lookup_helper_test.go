@@ -0,0 +1,45 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import "testing"
+
+func TestLongestMatchingZone(t *testing.T) {
+	zones := []string{"example.com", "sub.example.com", "other.com", "2.0.192.in-addr.arpa"}
+
+	tests := map[string]string{
+		"example.com":                         "example.com",
+		"www.example.com.":                    "example.com",
+		"_acme-challenge.WWW.Sub.Example.com": "sub.example.com",
+		"badexample.com":                      "",
+		"www.example.org":                     "",
+		"1.2.0.192.in-addr.arpa.":             "2.0.192.in-addr.arpa",
+	}
+
+	for domain, expected := range tests {
+		if actual := longestMatchingZone(zones, domain); actual != expected {
+			t.Errorf("longestMatchingZone(%s): expected %q, got %q", domain, expected, actual)
+		}
+	}
+}
+
+func TestRelativeRecordName(t *testing.T) {
+	tests := []struct {
+		domain   string
+		zone     string
+		expected string
+		ok       bool
+	}{
+		{domain: "example.com.", zone: "example.com", expected: "@", ok: true},
+		{domain: "_acme-challenge.www.example.com", zone: "Example.com.", expected: "_acme-challenge.www", ok: true},
+		{domain: "www.example.org", zone: "example.com", expected: "", ok: false},
+	}
+
+	for _, test := range tests {
+		actual, ok := relativeRecordName(test.domain, test.zone)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("relativeRecordName(%s, %s): expected %q, %t, got %q, %t", test.domain, test.zone, test.expected, test.ok, actual, ok)
+		}
+	}
+}
@@ -30,3 +30,35 @@ func StringPointerToTerraformString(input *string) types.String {
 	}
 	return types.StringPointerValue(input)
 }
+
+// StringToTerraformString convert string to types.String, an empty string is converted to null.
+func StringToTerraformString(input string) types.String {
+	if input == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(input)
+}
+
+// StringsToTerraformStrings convert []string to []types.String, a nil slice is kept nil.
+func StringsToTerraformStrings(input []string) []types.String {
+	if input == nil {
+		return nil
+	}
+	output := make([]types.String, len(input))
+	for i, value := range input {
+		output[i] = types.StringValue(value)
+	}
+	return output
+}
+
+// TerraformStringsToStrings convert []types.String to []string, a nil slice is kept nil.
+func TerraformStringsToStrings(input []types.String) []string {
+	if input == nil {
+		return nil
+	}
+	output := make([]string, len(input))
+	for i, value := range input {
+		output[i] = value.ValueString()
+	}
+	return output
+}
@@ -0,0 +1,212 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package zonefile
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MaxStringLength is the maximum length of a character string of a TXT record.
+const MaxStringLength = 255
+
+// Zone is the content of a zone file.
+type Zone struct {
+	// Origin is the name of the zone.
+	Origin string
+	// TTL is formatted as the $TTL directive if set.
+	TTL *int
+	// SOA is formatted as the first record if set.
+	SOA *SOA
+	// Records are the records of the zone with absolute names.
+	Records []Record
+	// Placeholders are the fields whose values are not known, e.g. ttl, mname, rname or serial. They are formatted with a
+	// comment that marks them as placeholders.
+	Placeholders []string
+}
+
+// SOA is the start of authority record of a zone.
+type SOA struct {
+	MName   string
+	RName   string
+	Serial  int
+	Refresh int
+	Retry   int
+	Expire  int
+	Minimum int
+}
+
+// Format formats the zone as a zone file. The records are sorted by name in canonical DNS order and by type, with
+// the NS records first. The order of records of the same name and type is kept.
+func Format(zone Zone) string {
+	origin := Fqdn(zone.Origin)
+
+	// placeholder returns true if the value of the field is a placeholder
+	placeholder := func(field string) bool {
+		for _, p := range zone.Placeholders {
+			if p == field {
+				return true
+			}
+		}
+		return false
+	}
+
+	var b strings.Builder
+	b.WriteString("$ORIGIN " + origin + "\n")
+	if zone.TTL != nil {
+		b.WriteString("$TTL " + strconv.Itoa(*zone.TTL))
+		if placeholder("ttl") {
+			b.WriteString("\t; placeholder")
+		}
+		b.WriteString("\n")
+	}
+
+	if zone.SOA != nil {
+		soa := zone.SOA
+		b.WriteString("@\t\tIN\tSOA\t" + soa.MName + " " + soa.RName + " (")
+		switch {
+		case placeholder("mname") && placeholder("rname"):
+			b.WriteString("\t; mname and rname are placeholders")
+		case placeholder("mname"):
+			b.WriteString("\t; mname is a placeholder")
+		case placeholder("rname"):
+			b.WriteString("\t; rname is a placeholder")
+		}
+		b.WriteString("\n")
+		for _, field := range []struct {
+			value int
+			name  string
+		}{
+			{soa.Serial, "serial"},
+			{soa.Refresh, "refresh"},
+			{soa.Retry, "retry"},
+			{soa.Expire, "expire"},
+			{soa.Minimum, "minimum"},
+		} {
+			b.WriteString("\t\t\t\t" + strconv.Itoa(field.value) + "\t; " + field.name)
+			if placeholder(field.name) {
+				b.WriteString(", placeholder")
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("\t\t\t\t)\n")
+	}
+
+	records := append([]Record{}, zone.Records...)
+	sort.SliceStable(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if !strings.EqualFold(a.Name, b.Name) {
+			return canonicalLess(a.Name, b.Name)
+		}
+		return typeLess(a.Type, b.Type)
+	})
+
+	for _, record := range records {
+		name, ok := RelativeName(record.Name, origin)
+		if !ok {
+			name = record.Name
+		}
+
+		ttl := ""
+		if record.TTL != nil {
+			ttl = strconv.Itoa(*record.TTL)
+		}
+
+		data := record.Data
+		if strings.EqualFold(record.Type, "TXT") {
+			data = formatTXT(data)
+		}
+
+		b.WriteString(name + "\t" + ttl + "\tIN\t" + strings.ToUpper(record.Type) + "\t" + data)
+		if record.Comment != "" {
+			b.WriteString("\t; " + strings.Join(strings.Fields(record.Comment), " "))
+		}
+		b.WriteString("\n")
+	}
+
+	return b.String()
+}
+
+// QuoteTXT returns the text as quoted character strings of at most 255 characters each, separated by spaces.
+func QuoteTXT(text string) string {
+	var strs []string
+	for {
+		chunk := text
+		if len(chunk) > MaxStringLength {
+			chunk = chunk[:MaxStringLength]
+		}
+		text = text[len(chunk):]
+
+		escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(chunk)
+		strs = append(strs, "\""+escaped+"\"")
+
+		if text == "" {
+			return strings.Join(strs, " ")
+		}
+	}
+}
+
+// formatTXT returns the TXT record data as is if it is already in the format of quoted character strings, or else
+// quotes it.
+func formatTXT(data string) string {
+	if strings.HasPrefix(data, "\"") {
+		if entries, err := tokenize(data); err == nil && len(entries) == 1 {
+			quoted := true
+			for _, t := range entries[0].tokens {
+				quoted = quoted && t.quoted
+			}
+			if quoted {
+				return data
+			}
+		}
+	}
+	return QuoteTXT(data)
+}
+
+// UnquoteTXT returns the text of TXT record data in the format of quoted character strings, with the strings joined
+// and the escapes resolved. Other data is returned as is.
+func UnquoteTXT(data string) string {
+	if !strings.HasPrefix(data, "\"") {
+		return data
+	}
+
+	entries, err := tokenize(data)
+	if err != nil || len(entries) != 1 {
+		return data
+	}
+
+	var b strings.Builder
+	for _, t := range entries[0].tokens {
+		if !t.quoted {
+			return data
+		}
+		b.WriteString(unescape(t.text))
+	}
+	return b.String()
+}
+
+// canonicalLess returns true if the name a sorts before the name b in canonical DNS order, i.e. by label from right
+// to left, so that the names of a subdomain are kept together.
+func canonicalLess(a, b string) bool {
+	aLabels := strings.Split(strings.TrimSuffix(strings.ToLower(a), "."), ".")
+	bLabels := strings.Split(strings.TrimSuffix(strings.ToLower(b), "."), ".")
+
+	for i := 1; i <= len(aLabels) && i <= len(bLabels); i++ {
+		aLabel, bLabel := aLabels[len(aLabels)-i], bLabels[len(bLabels)-i]
+		if aLabel != bLabel {
+			return aLabel < bLabel
+		}
+	}
+	return len(aLabels) < len(bLabels)
+}
+
+// typeLess returns true if the record type a sorts before the record type b, with the NS records first.
+func typeLess(a, b string) bool {
+	a, b = strings.ToUpper(a), strings.ToUpper(b)
+	if (a == "NS") != (b == "NS") {
+		return a == "NS"
+	}
+	return a < b
+}
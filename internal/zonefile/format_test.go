@@ -0,0 +1,144 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package zonefile
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	zone := Zone{
+		Origin: "example.com",
+		TTL:    intPointer(3600),
+		SOA: &SOA{
+			MName:   "ns1.abion.com.",
+			RName:   "hostmaster.example.com.",
+			Serial:  1,
+			Refresh: 10800,
+			Retry:   3600,
+			Expire:  1209600,
+			Minimum: 3600,
+		},
+		Records: []Record{
+			{Name: "www.example.com.", Type: "A", Data: "192.0.2.2", Comment: "second\nweb server"},
+			{Name: "www.example.com.", Type: "A", TTL: intPointer(300), Data: "192.0.2.1"},
+			{Name: "a.www.example.com.", Type: "A", Data: "192.0.2.3"},
+			{Name: "example.com.", Type: "TXT", Data: `say "hi"`},
+			{Name: "example.com.", Type: "NS", Data: "ns1.abion.com."},
+			{Name: "dkim.example.com.", Type: "TXT", Data: `"v=DKIM1; " "p=MIGf"`},
+			{Name: "example.com.", Type: "MX", Data: "10 mail.example.com."},
+		},
+	}
+
+	expected := `$ORIGIN example.com.
+$TTL 3600
+@		IN	SOA	ns1.abion.com. hostmaster.example.com. (
+				1	; serial
+				10800	; refresh
+				3600	; retry
+				1209600	; expire
+				3600	; minimum
+				)
+@		IN	NS	ns1.abion.com.
+@		IN	MX	10 mail.example.com.
+@		IN	TXT	"say \"hi\""
+dkim		IN	TXT	"v=DKIM1; " "p=MIGf"
+www		IN	A	192.0.2.2	; second web server
+www	300	IN	A	192.0.2.1
+a.www		IN	A	192.0.2.3
+`
+
+	content := Format(zone)
+	if content != expected {
+		t.Fatalf("unexpected zone file:\n%s", content)
+	}
+
+	// The formatted zone file parses back into the same records
+	records, err := Parse(content, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(records) != len(zone.Records)+1 || records[0].Type != "SOA" {
+		t.Fatalf("expected the SOA and %d records, got %+v", len(zone.Records), records)
+	}
+	if records[3].Data != `say "hi"` || records[4].Data != `"v=DKIM1; " "p=MIGf"` {
+		t.Errorf("unexpected TXT records %+v, %+v", records[3], records[4])
+	}
+}
+
+func TestFormatPlaceholders(t *testing.T) {
+	zone := Zone{
+		Origin: "example.com",
+		TTL:    intPointer(3600),
+		SOA: &SOA{
+			MName:   "ns1.abion.com.",
+			RName:   "hostmaster.example.com.",
+			Serial:  1,
+			Refresh: 10800,
+			Retry:   900,
+			Expire:  1209600,
+			Minimum: 3600,
+		},
+		Placeholders: []string{"ttl", "rname", "serial", "retry"},
+	}
+
+	expected := `$ORIGIN example.com.
+$TTL 3600	; placeholder
+@		IN	SOA	ns1.abion.com. hostmaster.example.com. (	; rname is a placeholder
+				1	; serial, placeholder
+				10800	; refresh
+				900	; retry, placeholder
+				1209600	; expire
+				3600	; minimum
+				)
+`
+
+	content := Format(zone)
+	if content != expected {
+		t.Fatalf("unexpected zone file:\n%s", content)
+	}
+
+	if _, err := Parse(content, "example.com"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+}
+
+func TestQuoteTXT(t *testing.T) {
+	long := strings.Repeat("a", 300)
+
+	quoted := QuoteTXT(long)
+	if quoted != `"`+strings.Repeat("a", 255)+`" "`+strings.Repeat("a", 45)+`"` {
+		t.Fatalf("unexpected quoted text %s", quoted)
+	}
+
+	records, err := Parse("@ TXT "+quoted, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if records[0].Data != quoted {
+		t.Errorf("expected %s, got %s", quoted, records[0].Data)
+	}
+
+	if quoted := QuoteTXT(""); quoted != `""` {
+		t.Errorf("expected an empty string, got %s", quoted)
+	}
+}
+
+func TestUnquoteTXT(t *testing.T) {
+	tests := map[string]string{
+		`v=spf1 -all`:                      `v=spf1 -all`,
+		`"v=DKIM1; " "p=MIGf"`:             `v=DKIM1; p=MIGf`,
+		`"say \"hi\"" "\059"`:              `say "hi";`,
+		`"unterminated`:                    `"unterminated`,
+		`"quoted" unquoted`:                `"quoted" unquoted`,
+		QuoteTXT(strings.Repeat("a", 300)): strings.Repeat("a", 300),
+	}
+
+	for data, expected := range tests {
+		if actual := UnquoteTXT(data); actual != expected {
+			t.Errorf("UnquoteTXT(%s): expected %s, got %s", data, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,506 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+// Package zonefile parses and formats DNS zone files in the RFC 1035 master file format.
+package zonefile
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Record is a resource record of a zone file.
+type Record struct {
+	// Name is the absolute owner name of the record in lower case, with a trailing dot.
+	Name string
+	// Type is the record type in upper case.
+	Type string
+	// TTL is the time-to-live of the record in seconds, nil if neither the record nor a $TTL directive sets it.
+	TTL *int
+	// Data is the record data in presentation format, with domain names made absolute.
+	Data string
+	// Line is the line of the zone file the record starts on.
+	Line int
+	// Comment is formatted as a ; comment after the record. It is not set by Parse.
+	Comment string
+}
+
+// Error is a parse error of a zone file.
+type Error struct {
+	Line    int
+	Message string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// token is a word or a quoted string of a zone file entry.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is a directive or a record of a zone file, which may span several lines within parentheses.
+type entry struct {
+	line       int
+	blankOwner bool
+	tokens     []token
+}
+
+// Parse parses the zone file content. Relative names are made absolute with the origin until it is changed by an
+// $ORIGIN directive. SOA records are returned like any other record.
+func Parse(content string, origin string) ([]Record, error) {
+	entries, err := tokenize(content)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser{origin: Fqdn(origin)}
+
+	var records []Record
+	for _, e := range entries {
+		record, err := p.parseEntry(e)
+		if err != nil {
+			return nil, err
+		}
+		if record != nil {
+			records = append(records, *record)
+		}
+	}
+
+	return records, nil
+}
+
+// tokenize splits the content into entries. Comments are removed, and lines within parentheses are joined.
+func tokenize(content string) ([]entry, error) {
+	var entries []entry
+
+	line := 1
+	depth := 0
+	parenLine := 0
+	var current *entry
+
+	flush := func() {
+		if current != nil && len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+
+	start := func(blankOwner bool) {
+		if current == nil {
+			current = &entry{line: line, blankOwner: blankOwner}
+		}
+	}
+
+	atLineStart := true
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		switch {
+		case c == '\n':
+			if depth == 0 {
+				flush()
+			}
+			line++
+			atLineStart = true
+			continue
+		case c == '\r':
+			continue
+		case c == ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+			continue
+		case c == ' ' || c == '\t':
+			if atLineStart && depth == 0 {
+				start(true)
+			}
+			atLineStart = false
+			continue
+		}
+		atLineStart = false
+
+		switch c {
+		case '(':
+			if depth == 0 {
+				parenLine = line
+			}
+			start(false)
+			depth++
+		case ')':
+			if depth == 0 {
+				return nil, &Error{Line: line, Message: "unexpected )"}
+			}
+			depth--
+		case '"':
+			start(false)
+			text, end, ok := readQuoted(content, i+1)
+			if !ok {
+				return nil, &Error{Line: line, Message: "unterminated quoted string"}
+			}
+			line += strings.Count(content[i:end], "\n")
+			current.tokens = append(current.tokens, token{text: text, quoted: true})
+			i = end
+		default:
+			start(false)
+			end := i
+			for end < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[end])) {
+				if content[end] == '\\' && end+1 < len(content) {
+					end++
+				}
+				end++
+			}
+			current.tokens = append(current.tokens, token{text: content[i:end]})
+			i = end - 1
+		}
+	}
+
+	if depth > 0 {
+		return nil, &Error{Line: parenLine, Message: "unbalanced parentheses"}
+	}
+	flush()
+
+	return entries, nil
+}
+
+// readQuoted reads the quoted string starting after the opening quote at start. It returns the string with escapes
+// kept as in the content, and the index of the closing quote.
+func readQuoted(content string, start int) (string, int, bool) {
+	for i := start; i < len(content); i++ {
+		switch content[i] {
+		case '\\':
+			i++
+		case '"':
+			return content[start:i], i, true
+		}
+	}
+	return "", 0, false
+}
+
+// parser holds the state of the directives and the previous owner name while the entries are parsed.
+type parser struct {
+	origin     string
+	defaultTTL *int
+	lastOwner  string
+}
+
+// parseEntry parses a directive or a record. It returns nil for directives.
+func (p *parser) parseEntry(e entry) (*Record, error) {
+	tokens := e.tokens
+	fail := func(format string, a ...any) (*Record, error) {
+		return nil, &Error{Line: e.line, Message: fmt.Sprintf(format, a...)}
+	}
+
+	if !e.blankOwner && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+		directive := strings.ToUpper(tokens[0].text)
+		switch directive {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return fail("$ORIGIN requires a single domain name")
+			}
+			p.origin = p.absolute(tokens[1].text)
+		case "$TTL":
+			if len(tokens) != 2 {
+				return fail("$TTL requires a single time-to-live")
+			}
+			ttl, err := ParseTTL(tokens[1].text)
+			if err != nil {
+				return fail("invalid $TTL: %s", err)
+			}
+			p.defaultTTL = &ttl
+		case "$INCLUDE", "$GENERATE":
+			return fail("%s is not supported", directive)
+		default:
+			return fail("unknown directive %s", tokens[0].text)
+		}
+		return nil, nil
+	}
+
+	record := Record{Line: e.line}
+
+	if e.blankOwner {
+		if p.lastOwner == "" {
+			return fail("record without an owner name")
+		}
+		record.Name = p.lastOwner
+	} else {
+		if tokens[0].quoted {
+			return fail("invalid owner name %q", tokens[0].text)
+		}
+		record.Name = strings.ToLower(p.absolute(tokens[0].text))
+		tokens = tokens[1:]
+	}
+	p.lastOwner = record.Name
+
+	// The ttl and the class are optional and may be given in any order before the type
+	for len(tokens) > 0 && record.Type == "" {
+		text := tokens[0].text
+		upper := strings.ToUpper(text)
+
+		switch {
+		case tokens[0].quoted:
+			return fail("invalid record type %q", text)
+		case upper == "IN":
+		case upper == "CH" || upper == "HS" || upper == "CS":
+			return fail("unsupported class %s, only IN is supported", text)
+		case text[0] >= '0' && text[0] <= '9':
+			if record.TTL != nil {
+				return fail("invalid record type %s", text)
+			}
+			ttl, err := ParseTTL(text)
+			if err != nil {
+				return fail("invalid time-to-live: %s", err)
+			}
+			record.TTL = &ttl
+		case parsers[upper] != nil:
+			record.Type = upper
+		default:
+			return fail("unsupported record type %s", text)
+		}
+		tokens = tokens[1:]
+	}
+
+	if record.Type == "" {
+		return fail("missing record type")
+	}
+
+	if record.TTL == nil && p.defaultTTL != nil {
+		ttl := *p.defaultTTL
+		record.TTL = &ttl
+	}
+
+	data, err := parsers[record.Type](p, tokens)
+	if err != nil {
+		return fail("invalid %s record: %s", record.Type, err)
+	}
+	record.Data = data
+
+	return &record, nil
+}
+
+// absolute returns the name made absolute with the origin.
+func (p *parser) absolute(name string) string {
+	switch {
+	case name == "@":
+		return p.origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + p.origin
+	}
+}
+
+// dataParser parses the data tokens of a record type into the record data in presentation format.
+type dataParser func(p *parser, tokens []token) (string, error)
+
+// parsers are the data parsers of the supported record types.
+var parsers = map[string]dataParser{
+	"A":     parseA,
+	"AAAA":  parseAAAA,
+	"CAA":   parseCAA,
+	"CNAME": parseDomainName,
+	"MX":    parseMX,
+	"NS":    parseDomainName,
+	"PTR":   parseDomainName,
+	"SOA":   parseSOA,
+	"SRV":   parseSRV,
+	"TXT":   parseTXT,
+}
+
+func parseA(_ *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 1); err != nil {
+		return "", err
+	}
+	ip := net.ParseIP(tokens[0].text)
+	if ip == nil || ip.To4() == nil || strings.Contains(tokens[0].text, ":") {
+		return "", fmt.Errorf("%s is not an IPv4 address", tokens[0].text)
+	}
+	return ip.String(), nil
+}
+
+func parseAAAA(_ *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 1); err != nil {
+		return "", err
+	}
+	ip := net.ParseIP(tokens[0].text)
+	if ip == nil || !strings.Contains(tokens[0].text, ":") {
+		return "", fmt.Errorf("%s is not an IPv6 address", tokens[0].text)
+	}
+	return ip.String(), nil
+}
+
+func parseDomainName(p *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 1); err != nil {
+		return "", err
+	}
+	return p.absolute(tokens[0].text), nil
+}
+
+func parseMX(p *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 2); err != nil {
+		return "", err
+	}
+	if err := expectUint16("preference", tokens[0].text); err != nil {
+		return "", err
+	}
+	return tokens[0].text + " " + p.absolute(tokens[1].text), nil
+}
+
+func parseSRV(p *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 4); err != nil {
+		return "", err
+	}
+	for i, field := range []string{"priority", "weight", "port"} {
+		if err := expectUint16(field, tokens[i].text); err != nil {
+			return "", err
+		}
+	}
+	return tokens[0].text + " " + tokens[1].text + " " + tokens[2].text + " " + p.absolute(tokens[3].text), nil
+}
+
+func parseCAA(_ *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 3); err != nil {
+		return "", err
+	}
+	flag, err := strconv.ParseUint(tokens[0].text, 10, 8)
+	if err != nil {
+		return "", fmt.Errorf("flag %s is not a number between 0 and 255", tokens[0].text)
+	}
+	if tokens[1].quoted || tokens[1].text == "" {
+		return "", fmt.Errorf("invalid tag %q", tokens[1].text)
+	}
+	return strconv.FormatUint(flag, 10) + " " + strings.ToLower(tokens[1].text) + " \"" + tokens[2].text + "\"", nil
+}
+
+// parseTXT returns the text of a single string as is. Several strings are returned as quoted strings separated by
+// spaces, so that the split of the strings is kept.
+func parseTXT(_ *parser, tokens []token) (string, error) {
+	if len(tokens) == 0 {
+		return "", fmt.Errorf("missing text")
+	}
+	if len(tokens) == 1 {
+		return unescape(tokens[0].text), nil
+	}
+
+	// The escapes of each string are resolved, and only quotes and backslashes are escaped again
+	strs := make([]string, len(tokens))
+	for i, t := range tokens {
+		strs[i] = "\"" + txtEscaper.Replace(unescape(t.text)) + "\""
+	}
+	return strings.Join(strs, " "), nil
+}
+
+// txtEscaper escapes the quotes and backslashes of a quoted character string.
+var txtEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// unescape returns the text with the \X and \DDD escapes of the master file format resolved.
+func unescape(text string) string {
+	if !strings.Contains(text, "\\") {
+		return text
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] != '\\' || i+1 == len(text) {
+			b.WriteByte(text[i])
+			continue
+		}
+		if i+3 < len(text) {
+			if code, err := strconv.ParseUint(text[i+1:i+4], 10, 8); err == nil {
+				b.WriteByte(byte(code))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(text[i+1])
+		i++
+	}
+	return b.String()
+}
+
+func parseSOA(p *parser, tokens []token) (string, error) {
+	if err := expectFields(tokens, 7); err != nil {
+		return "", err
+	}
+	fields := []string{p.absolute(tokens[0].text), p.absolute(tokens[1].text)}
+	for _, t := range tokens[2:] {
+		value, err := ParseTTL(t.text)
+		if err != nil {
+			return "", err
+		}
+		fields = append(fields, strconv.Itoa(value))
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// expectFields verifies the number of data fields of a record.
+func expectFields(tokens []token, count int) error {
+	if len(tokens) != count {
+		return fmt.Errorf("expected %d fields, got %d", count, len(tokens))
+	}
+	return nil
+}
+
+// expectUint16 verifies that the value of a data field is a number between 0 and 65535.
+func expectUint16(field string, value string) error {
+	if _, err := strconv.ParseUint(value, 10, 16); err != nil {
+		return fmt.Errorf("%s %s is not a number between 0 and 65535", field, value)
+	}
+	return nil
+}
+
+// ParseTTL parses a time-to-live in seconds, or in the BIND format with units, e.g. 1h30m or 2D.
+func ParseTTL(value string) (int, error) {
+	if seconds, err := strconv.ParseUint(value, 10, 31); err == nil {
+		return int(seconds), nil
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	total := 0
+	number := ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			number += string(c)
+			continue
+		}
+
+		unit, ok := units[c|0x20]
+		if !ok || number == "" || len(number) > 10 {
+			return 0, fmt.Errorf("%s is not a valid time-to-live", value)
+		}
+		n, _ := strconv.Atoi(number)
+		total += n * unit
+		number = ""
+	}
+
+	if value == "" || number != "" || total > 1<<31-1 {
+		return 0, fmt.Errorf("%s is not a valid time-to-live", value)
+	}
+	return total, nil
+}
+
+// Fqdn returns the name in lower case with a trailing dot.
+func Fqdn(name string) string {
+	return strings.TrimSuffix(strings.ToLower(name), ".") + "."
+}
+
+// RelativeName returns the name relative to the zone, "@" for the zone itself. It returns false if the absolute
+// name is not within the zone.
+func RelativeName(name string, zone string) (string, bool) {
+	name = strings.ToLower(name)
+	zone = Fqdn(zone)
+
+	if name == zone {
+		return "@", true
+	}
+	if relative, found := strings.CutSuffix(name, "."+zone); found {
+		return relative, true
+	}
+	return "", false
+}
@@ -0,0 +1,184 @@
+// Copyright (c) Abion AB
+// SPDX-License-Identifier: Apache-2.0
+
+package zonefile
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func intPointer(value int) *int {
+	return &value
+}
+
+func TestParse(t *testing.T) {
+	content := `$TTL 1h
+; Legacy zone of example.com
+@       IN SOA ns1.abion.com. hostmaster (
+                2024010101 ; serial
+                3h         ; refresh
+                1h         ; retry
+                2w         ; expire
+                1h )       ; minimum
+        IN NS   ns1.abion.com.
+www     300 IN A 192.0.2.1
+        IN 600 A 192.0.2.2
+ipv6    AAAA 2001:DB8::1
+mail    MX 10 mx1
+alias   CNAME www
+_sip._tcp SRV 10 60 5060 sip.example.net.
+@       CAA 0 ISSUE "letsencrypt.org"
+@       TXT "v=spf1 -all"
+dkim    TXT ( "v=DKIM1; k=rsa; "
+              "p=MIGf" )
+quote   TXT "say \"hi\"; now"
+
+$ORIGIN east.example.com.
+host    A 192.0.2.3
+Upper.Case.Example.Com. A 192.0.2.4
+`
+
+	records, err := Parse(content, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []Record{
+		{Name: "example.com.", Type: "SOA", TTL: intPointer(3600), Data: "ns1.abion.com. hostmaster.example.com. 2024010101 10800 3600 1209600 3600", Line: 3},
+		{Name: "example.com.", Type: "NS", TTL: intPointer(3600), Data: "ns1.abion.com.", Line: 9},
+		{Name: "www.example.com.", Type: "A", TTL: intPointer(300), Data: "192.0.2.1", Line: 10},
+		{Name: "www.example.com.", Type: "A", TTL: intPointer(600), Data: "192.0.2.2", Line: 11},
+		{Name: "ipv6.example.com.", Type: "AAAA", TTL: intPointer(3600), Data: "2001:db8::1", Line: 12},
+		{Name: "mail.example.com.", Type: "MX", TTL: intPointer(3600), Data: "10 mx1.example.com.", Line: 13},
+		{Name: "alias.example.com.", Type: "CNAME", TTL: intPointer(3600), Data: "www.example.com.", Line: 14},
+		{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: intPointer(3600), Data: "10 60 5060 sip.example.net.", Line: 15},
+		{Name: "example.com.", Type: "CAA", TTL: intPointer(3600), Data: "0 issue \"letsencrypt.org\"", Line: 16},
+		{Name: "example.com.", Type: "TXT", TTL: intPointer(3600), Data: "v=spf1 -all", Line: 17},
+		{Name: "dkim.example.com.", Type: "TXT", TTL: intPointer(3600), Data: "\"v=DKIM1; k=rsa; \" \"p=MIGf\"", Line: 18},
+		{Name: "quote.example.com.", Type: "TXT", TTL: intPointer(3600), Data: "say \"hi\"; now", Line: 20},
+		{Name: "host.east.example.com.", Type: "A", TTL: intPointer(3600), Data: "192.0.2.3", Line: 23},
+		{Name: "upper.case.example.com.", Type: "A", TTL: intPointer(3600), Data: "192.0.2.4", Line: 24},
+	}
+
+	if !reflect.DeepEqual(records, expected) {
+		for i := range records {
+			if i >= len(expected) || !reflect.DeepEqual(records[i], expected[i]) {
+				t.Errorf("record %d: got %+v", i, records[i])
+			}
+		}
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+}
+
+func TestParseWithoutTTL(t *testing.T) {
+	records, err := Parse("www A 192.0.2.1\n", "example.com.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(records) != 1 || records[0].TTL != nil {
+		t.Fatalf("expected a single record without ttl, got %+v", records)
+	}
+}
+
+func TestParseTXTEscapes(t *testing.T) {
+	records, err := Parse("a TXT \"x\\\"y\" \"z\"\nb TXT \"x\\\"yz\"\nc TXT \"\\065\" \"\\\\\"\n", "example.com.")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if records[0].Data != `"x\"y" "z"` {
+		t.Errorf("unexpected data %s", records[0].Data)
+	}
+	if UnquoteTXT(records[0].Data) != records[1].Data {
+		t.Errorf("expected the text of %s to be %s", records[0].Data, records[1].Data)
+	}
+	if records[2].Data != `"A" "\\"` || UnquoteTXT(records[2].Data) != `A\` {
+		t.Errorf("unexpected data %s", records[2].Data)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		line    int
+		message string
+	}{
+		{"unknown type", "@ NS ns1.abion.com.\nwww IN DS 1 2 3 4\n", 2, "unsupported record type DS"},
+		{"unsupported class", "www CH A 192.0.2.1\n", 1, "unsupported class CH, only IN is supported"},
+		{"missing owner", "  A 192.0.2.1\n", 1, "record without an owner name"},
+		{"missing type", "www 300 IN\n", 1, "missing record type"},
+		{"invalid address", "\n\nwww A 192.0.2\n", 3, "invalid A record: 192.0.2 is not an IPv4 address"},
+		{"invalid ipv6 address", "www AAAA 192.0.2.1\n", 1, "invalid AAAA record: 192.0.2.1 is not an IPv6 address"},
+		{"invalid preference", "@ MX 70000 mail\n", 1, "invalid MX record: preference 70000 is not a number between 0 and 65535"},
+		{"field count", "@ SRV 10 60 sip.example.net.\n", 1, "invalid SRV record: expected 4 fields, got 3"},
+		{"invalid ttl", "$TTL 1x\n", 1, "invalid $TTL: 1x is not a valid time-to-live"},
+		{"include", "$INCLUDE other.zone\n", 1, "$INCLUDE is not supported"},
+		{"unknown directive", "$FOO bar\n", 1, "unknown directive $FOO"},
+		{"unbalanced parentheses", "@ A 192.0.2.1\n@ SOA ns1 host ( 1 2 3\n4 5\n", 2, "unbalanced parentheses"},
+		{"unexpected parenthesis", "@ A 192.0.2.1 )\n", 1, "unexpected )"},
+		{"unterminated string", "@ A 192.0.2.1\n@ TXT \"abc\n", 2, "unterminated quoted string"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse(test.content, "example.com")
+
+			var parseErr *Error
+			if !errors.As(err, &parseErr) {
+				t.Fatalf("expected a parse error, got %v", err)
+			}
+			if parseErr.Line != test.line || parseErr.Message != test.message {
+				t.Errorf("expected line %d: %s, got %s", test.line, test.message, err)
+			}
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	tests := map[string]int{
+		"0":     0,
+		"3600":  3600,
+		"1h30m": 5400,
+		"2D":    172800,
+		"1w1d":  691200,
+		"45s":   45,
+	}
+
+	for value, expected := range tests {
+		ttl, err := ParseTTL(value)
+		if err != nil || ttl != expected {
+			t.Errorf("ParseTTL(%q) = %d, %v, expected %d", value, ttl, err, expected)
+		}
+	}
+
+	for _, value := range []string{"", "h", "1h30", "-1", "1.5h", "99999999999h"} {
+		if _, err := ParseTTL(value); err == nil {
+			t.Errorf("ParseTTL(%q) expected an error", value)
+		}
+	}
+}
+
+func TestRelativeName(t *testing.T) {
+	tests := []struct {
+		name     string
+		zone     string
+		relative string
+		ok       bool
+	}{
+		{"example.com.", "example.com", "@", true},
+		{"www.example.com.", "example.com.", "www", true},
+		{"a.b.Example.com.", "EXAMPLE.com", "a.b", true},
+		{"www.example.org.", "example.com", "", false},
+		{"badexample.com.", "example.com", "", false},
+	}
+
+	for _, test := range tests {
+		relative, ok := RelativeName(test.name, test.zone)
+		if relative != test.relative || ok != test.ok {
+			t.Errorf("RelativeName(%q, %q) = %q, %t, expected %q, %t", test.name, test.zone, relative, ok, test.relative, test.ok)
+		}
+	}
+}